@@ -45,13 +45,20 @@ package main
 //      description = "Monitors healthcare infrastructure in ${each.key}"
 //      enabled     = true
 //
-//      config = {
-//        threat_level        = "high"
-//        monitoring_interval = "60"
-//        alert_email         = "security-${each.key}@hospital.example.com"
-//        region              = each.key
+//      threat_level = "high"
+//
+//      monitoring = {
+//        interval_seconds = 60
+//      }
+//
+//      alerting = {
+//        emails = ["security-${each.key}@hospital.example.com"]
 //      }
 //
+//      config = provider::sentinel::validate_config("apollo", {
+//        region = each.key
+//      })
+//
 //      tags = merge(local.common_tags, {
 //        sector      = "healthcare"
 //        region      = each.key
@@ -89,11 +96,14 @@ package main
 //      description = "Monitors ${each.value.type} in ${each.value.location}"
 //      enabled     = each.value.monitoring_enabled
 //
+//      monitoring = {
+//        mode = each.value.mode
+//      }
+//
 //      config = {
-//        facility_type   = each.value.type
-//        location        = each.value.location
-//        monitoring_mode = each.value.mode
-//        capacity        = tostring(each.value.capacity)
+//        facility_type = each.value.type
+//        location      = each.value.location
+//        capacity      = tostring(each.value.capacity)
 //      }
 //
 //      tags = merge(local.common_tags, {
@@ -38,8 +38,8 @@ package main
 //      description = "Monitors energy infrastructure"
 //      enabled     = true
 //
-//      config = {
-//        monitoring_mode = "continuous"
+//      monitoring = {
+//        mode = "continuous"
 //      }
 //
 //      tags = {
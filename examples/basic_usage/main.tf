@@ -36,9 +36,10 @@ resource "sentinel_apollo" "hospital" {
   description = "Monitors critical healthcare infrastructure"
   enabled     = true
 
+  threat_level = "high"
+
   config = {
-    threat_level = "high"
-    region       = "us-east-1"
+    region = "us-east-1"
   }
 
   tags = {
@@ -54,9 +55,12 @@ resource "sentinel_ra" "power_grid" {
   description = "Monitors energy infrastructure"
   enabled     = true
 
-  config = {
-    monitoring_mode = "continuous"
-    alert_threshold = "medium"
+  monitoring = {
+    mode = "continuous"
+  }
+
+  alerting = {
+    threshold = "medium"
   }
 
   tags = {
@@ -0,0 +1,234 @@
+// Package catalog describes the sentry types offered by the Sentinel Project and
+// the critical infrastructure sectors they protect.
+package catalog
+
+import "strings"
+
+// Sentry describes a sentry type and the sector it protects.
+type Sentry struct {
+	// Type is the resource type suffix, e.g. "apollo" for sentinel_apollo.
+	Type string
+	// Name is the display name of the sentry, e.g. "Apollo".
+	Name string
+	// Sector is the canonical name of the critical infrastructure sector.
+	Sector string
+	// Description summarizes what the sector covers.
+	Description string
+	// Critical marks sectors whose loss of protection the risk team treats as
+	// critical. Destroying or disabling these sentries is guarded.
+	Critical bool
+
+	// configKeys are the sector-specific keys the sentry understands in addition
+	// to the common ones. Use ConfigKeys to get the full list.
+	configKeys []ConfigKey
+}
+
+// sentries is the sentry catalog, ordered by sentry type.
+var sentries = []Sentry{
+	{
+		Type: "apollo", Name: "Apollo", Sector: "Healthcare",
+		Description: "Hospitals, clinics, research laboratories, pharmaceutical and medical device makers, and health insurers.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"hospital", "clinic", "research_lab", "pharmaceutical", "medical_device", "insurance"}, Description: "Kind of healthcare facility monitored."},
+			{Name: "compliance_mode", Type: ConfigTypeString, Default: "hipaa", AllowedValues: []string{"hipaa", "hitrust", "none"}, Description: "Compliance regime the sentry enforces."},
+		},
+	},
+	{
+		Type: "ares", Name: "Ares", Sector: "Defense Industrial Base",
+		Description: "Contractors, manufacturers and research facilities that design and produce military systems.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"contractor", "manufacturing", "research"}, Description: "Kind of defense facility monitored."},
+			{Name: "clearance_level", Type: ConfigTypeString, Default: "cui", AllowedValues: []string{"unclassified", "cui", "secret"}, Description: "Highest classification of the data the sentry may inspect."},
+		},
+	},
+	{
+		Type: "athena", Name: "Athena", Sector: "Community-Based Governmental Organizations",
+		Description: "Non-governmental, community and faith-based organizations and local government services.",
+		configKeys: []ConfigKey{
+			{Name: "organization_type", Type: ConfigTypeString, AllowedValues: []string{"ngo", "community", "faith_based", "local_government"}, Description: "Kind of organization monitored."},
+		},
+	},
+	{
+		Type: "demeter", Name: "Demeter", Sector: "Food & Agriculture",
+		Description: "Farms, food processing, distribution and storage facilities.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"farm", "processing", "distribution", "storage"}, Description: "Kind of food or agriculture facility monitored."},
+		},
+	},
+	{
+		Type: "fenrir", Name: "Fenrir", Sector: "Information Technology",
+		Description: "Data centers, cloud platforms, software vendors and managed service providers.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"datacenter", "cloud", "software_vendor", "managed_service"}, Description: "Kind of IT environment monitored."},
+			{Name: "scan_depth", Type: ConfigTypeString, Default: "standard", AllowedValues: []string{"standard", "deep"}, Description: "How thoroughly the sentry inspects monitored systems."},
+		},
+	},
+	{
+		Type: "hermes", Name: "Hermes", Sector: "Transportation",
+		Description: "Aviation, rail, maritime, highway, pipeline and mass transit systems.",
+		configKeys: []ConfigKey{
+			{Name: "transport_mode", Type: ConfigTypeString, AllowedValues: []string{"aviation", "rail", "maritime", "highway", "pipeline", "mass_transit"}, Description: "Mode of transportation monitored."},
+		},
+	},
+	{
+		Type: "jupiter", Name: "Jupiter", Sector: "Government",
+		Description: "Federal, state, local, tribal and territorial government facilities.",
+		configKeys: []ConfigKey{
+			{Name: "jurisdiction", Type: ConfigTypeString, AllowedValues: []string{"federal", "state", "local", "tribal", "territorial"}, Description: "Level of government monitored."},
+		},
+	},
+	{
+		Type: "lir", Name: "Lir", Sector: "Water", Critical: true,
+		Description: "Drinking water and wastewater treatment and distribution systems.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"treatment", "distribution", "wastewater", "reservoir"}, Description: "Kind of water facility monitored."},
+		},
+	},
+	{
+		Type: "lugh", Name: "Lugh", Sector: "Postal & Shipping",
+		Description: "Postal services, parcel carriers and logistics hubs.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"sorting", "distribution", "last_mile"}, Description: "Kind of postal or shipping facility monitored."},
+		},
+	},
+	{
+		Type: "mercury", Name: "Mercury", Sector: "Commercial Facilities",
+		Description: "Venues where large numbers of people gather, such as stadiums, malls, hotels and entertainment sites.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"retail", "lodging", "entertainment", "sports", "real_estate"}, Description: "Kind of commercial facility monitored."},
+		},
+	},
+	{
+		Type: "morrigan", Name: "Morrigan", Sector: "Chemical",
+		Description: "Facilities that manufacture, store, use or transport hazardous chemicals.",
+		configKeys: []ConfigKey{
+			{Name: "hazard_class", Type: ConfigTypeString, AllowedValues: []string{"toxic", "flammable", "explosive", "corrosive"}, Description: "Hazard class of the chemicals handled at the monitored site."},
+		},
+	},
+	{
+		Type: "osiris", Name: "Osiris", Sector: "Emergency Services",
+		Description: "Law enforcement, fire, emergency medical services and emergency management.",
+		configKeys: []ConfigKey{
+			{Name: "service_type", Type: ConfigTypeString, AllowedValues: []string{"dispatch", "fire", "ems", "law_enforcement", "emergency_management"}, Description: "Kind of emergency service monitored."},
+		},
+	},
+	{
+		Type: "ptah", Name: "Ptah", Sector: "Critical Manufacturing",
+		Description: "Manufacturers of primary metals, machinery, electrical equipment and transportation equipment.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"primary_metals", "machinery", "electrical_equipment", "transportation_equipment"}, Description: "Kind of manufacturing facility monitored."},
+		},
+	},
+	{
+		Type: "ra", Name: "Ra", Sector: "Energy", Critical: true,
+		Description: "Electricity generation, transmission and distribution, and oil and gas production and delivery.",
+		configKeys: []ConfigKey{
+			{Name: "grid_region", Type: ConfigTypeString, Description: "Power grid region the monitored assets belong to."},
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"generation", "transmission", "distribution", "oil", "natural_gas"}, Description: "Kind of energy facility monitored."},
+			{Name: "location", Type: ConfigTypeString, Description: "Physical location of the monitored facility."},
+			{Name: "capacity", Type: ConfigTypeNumber, Description: "Capacity of the monitored facility in megawatts."},
+		},
+	},
+	{
+		Type: "shiva", Name: "Shiva", Sector: "Nuclear Reactors, Materials, and Waste", Critical: true,
+		Description: "Nuclear power plants, research reactors, fuel cycle facilities and radioactive waste management.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"reactor", "fuel_cycle", "waste_storage", "research"}, Description: "Kind of nuclear facility monitored."},
+			{Name: "reactor_count", Type: ConfigTypeNumber, Description: "Number of reactors at the monitored site."},
+		},
+	},
+	{
+		Type: "sobek", Name: "Sobek", Sector: "Dams", Critical: true,
+		Description: "Dams, levees, navigation locks and hydropower facilities.",
+		configKeys: []ConfigKey{
+			{Name: "facility_type", Type: ConfigTypeString, AllowedValues: []string{"hydroelectric", "navigation_lock", "flood_control", "levee"}, Description: "Kind of dam or water control structure monitored."},
+			{Name: "spillway_monitoring", Type: ConfigTypeBool, Default: "true", Description: "Whether the sentry monitors spillway gate controls."},
+		},
+	},
+	{
+		Type: "thoth", Name: "Thoth", Sector: "Telecommunications",
+		Description: "Wireline, wireless, satellite, cable and broadcast communication networks.",
+		configKeys: []ConfigKey{
+			{Name: "network_type", Type: ConfigTypeString, AllowedValues: []string{"wireline", "wireless", "satellite", "cable", "broadcast"}, Description: "Kind of telecommunications network monitored."},
+		},
+	},
+	{
+		Type: "tyche", Name: "Tyche", Sector: "Banking & Finance",
+		Description: "Banks, payment processors, exchanges, insurers and other financial institutions.",
+		configKeys: []ConfigKey{
+			{Name: "transaction_monitoring", Type: ConfigTypeString, Default: "enabled", AllowedValues: []string{"enabled", "disabled"}, Description: "Whether the sentry inspects financial transactions."},
+			{Name: "fraud_detection", Type: ConfigTypeString, Default: "basic", AllowedValues: []string{"basic", "advanced"}, Description: "Fraud detection model tier."},
+			{Name: "compliance_mode", Type: ConfigTypeString, AllowedValues: []string{"pci-dss", "sox", "glba", "none"}, Description: "Compliance regime the sentry enforces."},
+		},
+	},
+}
+
+// All returns every sentry in the catalog, ordered by sentry type.
+func All() []Sentry {
+	result := make([]Sentry, len(sentries))
+	copy(result, sentries)
+	return result
+}
+
+// Slug returns the sector name in lower case with every run of other characters
+// replaced by a single hyphen, e.g. "food-agriculture" for "Food & Agriculture".
+func (s Sentry) Slug() string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(s.Sector) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	return b.String()
+}
+
+// resourceTypePrefix prefixes the sentry type in Terraform resource types.
+const resourceTypePrefix = "sentinel_"
+
+// ResourceType returns the Terraform resource type of the sentry, e.g. "sentinel_apollo".
+func (s Sentry) ResourceType() string {
+	return resourceTypePrefix + s.Type
+}
+
+// Sectors returns the names of all sectors, ordered by sentry type.
+func Sectors() []string {
+	result := make([]string, len(sentries))
+	for i, sentry := range sentries {
+		result[i] = sentry.Sector
+	}
+	return result
+}
+
+// Lookup returns the catalog entry for the given sentry type.
+func Lookup(sentryType string) (Sentry, bool) {
+	for _, sentry := range sentries {
+		if sentry.Type == sentryType {
+			return sentry, true
+		}
+	}
+	return Sentry{}, false
+}
+
+// LookupResourceType returns the catalog entry for a sentry type given either
+// as a sentry type, e.g. "apollo", or as a Terraform resource type, e.g.
+// "sentinel_apollo".
+func LookupResourceType(resourceType string) (Sentry, bool) {
+	return Lookup(strings.TrimPrefix(resourceType, resourceTypePrefix))
+}
+
+// LookupSector returns the catalog entry for the sentry protecting the given sector.
+func LookupSector(sector string) (Sentry, bool) {
+	for _, sentry := range sentries {
+		if sentry.Sector == sector {
+			return sentry, true
+		}
+	}
+	return Sentry{}, false
+}
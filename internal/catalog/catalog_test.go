@@ -0,0 +1,105 @@
+package catalog
+
+import (
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	sentry, ok := Lookup("sobek")
+	if !ok {
+		t.Fatal("Expected sobek to be in the catalog")
+	}
+	if sentry.Sector != "Dams" || !sentry.Critical {
+		t.Errorf("Unexpected catalog entry for sobek: %+v", sentry)
+	}
+
+	if _, ok := Lookup("zeus"); ok {
+		t.Error("Expected zeus not to be in the catalog")
+	}
+}
+
+func TestLookupResourceType(t *testing.T) {
+	for _, resourceType := range []string{"sobek", "sentinel_sobek"} {
+		sentry, ok := LookupResourceType(resourceType)
+		if !ok || sentry.Type != "sobek" {
+			t.Errorf("Expected %q to resolve to sobek, got %+v", resourceType, sentry)
+		}
+	}
+
+	for _, resourceType := range []string{"sentinel_", "sentinel_zeus", "sentinel_sentinel_sobek", "Sentinel_sobek"} {
+		if sentry, ok := LookupResourceType(resourceType); ok {
+			t.Errorf("Expected %q not to be in the catalog, got %+v", resourceType, sentry)
+		}
+	}
+}
+
+func TestSlug(t *testing.T) {
+	testCases := map[string]string{
+		"shiva":   "nuclear-reactors-materials-and-waste",
+		"demeter": "food-agriculture",
+		"athena":  "community-based-governmental-organizations",
+		"sobek":   "dams",
+	}
+
+	for sentryType, expected := range testCases {
+		sentry, _ := Lookup(sentryType)
+		if slug := sentry.Slug(); slug != expected {
+			t.Errorf("Expected slug %q for %s, got %q", expected, sentryType, slug)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, sentry := range All() {
+		if seen[sentry.Slug()] {
+			t.Errorf("Duplicate slug %q", sentry.Slug())
+		}
+		seen[sentry.Slug()] = true
+	}
+}
+
+func TestNormalizeConfig(t *testing.T) {
+	sentry, _ := Lookup("sobek")
+
+	normalized, issues := sentry.NormalizeConfig(map[string]string{
+		"Facility-Type": "hydroelectric",
+		"gate_count":    "4",
+	})
+
+	if len(issues) != 1 || !issues[0].Unknown || issues[0].Key != "gate_count" {
+		t.Fatalf("Expected a single unknown key issue for gate_count, got %+v", issues)
+	}
+
+	expected := map[string]string{
+		"facility_type":       "hydroelectric",
+		"gate_count":          "4",
+		"audit_logging":       "enabled",
+		"spillway_monitoring": "true",
+	}
+	if len(normalized) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, normalized)
+	}
+	for key, value := range expected {
+		if normalized[key] != value {
+			t.Errorf("Expected %q to be %q, got %q", key, value, normalized[key])
+		}
+	}
+}
+
+func TestNormalizeConfigInvalid(t *testing.T) {
+	sentry, _ := Lookup("ra")
+
+	testCases := map[string]map[string]string{
+		"number":    {"capacity": "lots"},
+		"enum":      {"facility_type": "windmill"},
+		"duplicate": {"grid_region": "northeast", "Grid_Region": "southwest"},
+	}
+
+	for name, config := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, issues := sentry.NormalizeConfig(config)
+			if len(issues) != 1 || issues[0].Unknown {
+				t.Errorf("Expected a single invalid value issue, got %+v", issues)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package catalog
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Types of config values. Config values are always strings on the wire; the
+// type describes which strings are accepted.
+const (
+	ConfigTypeString = "string"
+	ConfigTypeNumber = "number"
+	ConfigTypeBool   = "bool"
+)
+
+// ConfigKey describes a key a sentry understands in its config map.
+type ConfigKey struct {
+	// Name is the canonical key name.
+	Name string
+	// Type is one of ConfigTypeString, ConfigTypeNumber or ConfigTypeBool.
+	Type string
+	// Default is the value the Sentinel API applies when the key is omitted.
+	// Empty when the key has no default.
+	Default string
+	// AllowedValues restricts string values. Empty when any value is accepted.
+	AllowedValues []string
+	// Description explains the key.
+	Description string
+}
+
+// commonConfigKeys are understood by every sentry.
+var commonConfigKeys = []ConfigKey{
+	{
+		Name:        "region",
+		Type:        ConfigTypeString,
+		Description: "Region the sentry is deployed in.",
+	},
+	{
+		Name:        "monitoring_zone",
+		Type:        ConfigTypeString,
+		Description: "Logical monitoring zone used to group sentries.",
+	},
+	{
+		Name:          "audit_logging",
+		Type:          ConfigTypeString,
+		Default:       "enabled",
+		AllowedValues: []string{"enabled", "disabled"},
+		Description:   "Whether the sentry records an audit log of its actions.",
+	},
+}
+
+// ConfigKeys returns the config keys the sentry understands, ordered by name.
+func (s Sentry) ConfigKeys() []ConfigKey {
+	keys := make([]ConfigKey, 0, len(commonConfigKeys)+len(s.configKeys))
+	keys = append(keys, commonConfigKeys...)
+	keys = append(keys, s.configKeys...)
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	return keys
+}
+
+// ConfigKey returns the config key with the given canonical name.
+func (s Sentry) ConfigKey(name string) (ConfigKey, bool) {
+	for _, key := range s.ConfigKeys() {
+		if key.Name == name {
+			return key, true
+		}
+	}
+	return ConfigKey{}, false
+}
+
+// CanonicalConfigKey returns the canonical spelling of a config key: lower case
+// with words separated by underscores.
+func CanonicalConfigKey(key string) string {
+	key = strings.ToLower(strings.TrimSpace(key))
+	return strings.NewReplacer("-", "_", " ", "_", ".", "_").Replace(key)
+}
+
+// Validate checks a value against the key's type and allowed values.
+func (k ConfigKey) Validate(value string) error {
+	switch k.Type {
+	case ConfigTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("config key %q must be a number, got %q", k.Name, value)
+		}
+	case ConfigTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("config key %q must be true or false, got %q", k.Name, value)
+		}
+	}
+
+	if len(k.AllowedValues) == 0 {
+		return nil
+	}
+	for _, allowed := range k.AllowedValues {
+		if value == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("config key %q must be one of %s, got %q", k.Name, strings.Join(k.AllowedValues, ", "), value)
+}
+
+// ConfigIssue describes a problem with a single config entry.
+type ConfigIssue struct {
+	// Key is the key as given in the config map.
+	Key string
+	// Unknown is set when the sentry does not understand the key at all.
+	Unknown bool
+	// Message describes the problem.
+	Message string
+}
+
+// NormalizeConfig checks a config map against the sentry's config keys. It
+// returns the map with canonical key spelling and defaults filled in, along
+// with every problem found. Unknown keys are carried over unchanged.
+func (s Sentry) NormalizeConfig(config map[string]string) (map[string]string, []ConfigIssue) {
+	var issues []ConfigIssue
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]string, len(config))
+	given := make(map[string]string, len(config))
+
+	for _, key := range keys {
+		value := config[key]
+		canonical := CanonicalConfigKey(key)
+
+		spec, ok := s.ConfigKey(canonical)
+		if !ok {
+			issues = append(issues, ConfigIssue{
+				Key:     key,
+				Unknown: true,
+				Message: fmt.Sprintf("config key %q is not supported by %s sentries", key, s.Name),
+			})
+			normalized[key] = value
+			continue
+		}
+
+		if previous, ok := given[canonical]; ok {
+			issues = append(issues, ConfigIssue{
+				Key:     key,
+				Message: fmt.Sprintf("config keys %q and %q both set %q", previous, key, canonical),
+			})
+			continue
+		}
+		given[canonical] = key
+
+		if err := spec.Validate(value); err != nil {
+			issues = append(issues, ConfigIssue{Key: key, Message: err.Error()})
+			continue
+		}
+
+		normalized[canonical] = value
+	}
+
+	for _, spec := range s.ConfigKeys() {
+		if _, ok := given[spec.Name]; !ok && spec.Default != "" {
+			normalized[spec.Name] = spec.Default
+		}
+	}
+
+	return normalized, issues
+}
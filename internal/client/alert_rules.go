@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// AlertRule raises alerts for the sentries in its scope when its conditions
+// are met, and delivers them to notification channels.
+type AlertRule struct {
+	ID                     string              `json:"id,omitempty"`
+	Name                   string              `json:"name"`
+	Description            string              `json:"description,omitempty"`
+	Enabled                bool                `json:"enabled"`
+	Scope                  AlertRuleScope      `json:"scope"`
+	Conditions             AlertRuleConditions `json:"conditions"`
+	ThrottleWindow         string              `json:"throttle_window,omitempty"`
+	DedupWindow            string              `json:"dedup_window,omitempty"`
+	NotificationChannelIDs []string            `json:"notification_channel_ids"`
+	UpdatedAt              time.Time           `json:"updated_at"`
+}
+
+// AlertRuleScope selects the sentries an alert rule applies to. Exactly one
+// of the fields is set.
+type AlertRuleScope struct {
+	SentryIDs []string          `json:"sentry_ids,omitempty"`
+	Sector    string            `json:"sector,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+// AlertRuleConditions describes when an alert rule fires: at least
+// EventCount events of at least MinSeverity within Window, optionally limited
+// to some detection categories.
+type AlertRuleConditions struct {
+	MinSeverity string   `json:"min_severity"`
+	EventCount  int64    `json:"event_count"`
+	Window      string   `json:"window"`
+	Categories  []string `json:"categories,omitempty"`
+}
+
+// CreateAlertRule creates an alert rule.
+func (c *Client) CreateAlertRule(ctx context.Context, rule AlertRule) (*AlertRule, error) {
+	var created AlertRule
+	if err := c.do(ctx, http.MethodPost, "/v1/alert-rules", nil, rule, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetAlertRule returns the alert rule with the given ID.
+func (c *Client) GetAlertRule(ctx context.Context, id string) (*AlertRule, error) {
+	var rule AlertRule
+	if err := c.do(ctx, http.MethodGet, "/v1/alert-rules/"+url.PathEscape(id), nil, nil, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// UpdateAlertRule replaces the alert rule with the given ID.
+func (c *Client) UpdateAlertRule(ctx context.Context, id string, rule AlertRule) (*AlertRule, error) {
+	var updated AlertRule
+	if err := c.do(ctx, http.MethodPut, "/v1/alert-rules/"+url.PathEscape(id), nil, rule, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteAlertRule deletes the alert rule with the given ID.
+func (c *Client) DeleteAlertRule(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v1/alert-rules/"+url.PathEscape(id), nil, nil, nil)
+}
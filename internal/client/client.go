@@ -0,0 +1,139 @@
+// Package client implements a minimal client for the Sentinel API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultEndpoint is the Sentinel API endpoint used when none is configured.
+const DefaultEndpoint = "https://api.sentinel-project.io"
+
+// Client talks to the Sentinel API on behalf of the provider.
+type Client struct {
+	endpoint   string
+	apiKey     string
+	userAgent  string
+	httpClient *http.Client
+}
+
+// New returns a client for the Sentinel API at endpoint, authenticating with apiKey.
+func New(endpoint, apiKey, version string) *Client {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+
+	return &Client{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		apiKey:     apiKey,
+		userAgent:  "terraform-provider-sentinel/" + version,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Endpoint returns the Sentinel API endpoint the client talks to.
+func (c *Client) Endpoint() string {
+	return c.endpoint
+}
+
+// APIError is returned when the Sentinel API responds with an error status.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("sentinel API returned status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("sentinel API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an APIError for a missing object.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsConflict reports whether err is an APIError for a request that conflicts
+// with existing objects.
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// IsForbidden reports whether err is an APIError for a request the API key is
+// not allowed to make.
+func IsForbidden(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+}
+
+// do sends a request to the Sentinel API and decodes the JSON response into out.
+// body and out may be nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	if c.apiKey == "" {
+		return errors.New("no Sentinel API key configured: set api_key in the provider configuration or the SENTINEL_API_KEY environment variable")
+	}
+
+	target := c.endpoint + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return fmt.Errorf("building request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		var payload struct {
+			Message string `json:"message"`
+		}
+		if data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<16)); err == nil {
+			if json.Unmarshal(data, &payload) == nil {
+				apiErr.Message = payload.Message
+			}
+		}
+		return apiErr
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
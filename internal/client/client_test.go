@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateSentryToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/sentries/apollo-hospital-1700000000/tokens" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("Unexpected Authorization header %q", r.Header.Get("Authorization"))
+		}
+
+		var req SentryTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request: %s", err)
+		}
+		if req.TTLSeconds != 900 {
+			t.Errorf("Expected ttl_seconds 900, got %d", req.TTLSeconds)
+		}
+
+		_ = json.NewEncoder(w).Encode(SentryToken{
+			ID:        "tok-1",
+			Token:     "secret",
+			Scopes:    req.Scopes,
+			ExpiresAt: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "test-key", "test")
+	token, err := c.CreateSentryToken(context.Background(), "apollo-hospital-1700000000", SentryTokenRequest{
+		TTLSeconds: 900,
+		Scopes:     []string{"read"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token.ID != "tok-1" || token.Token != "secret" {
+		t.Errorf("Unexpected token %+v", token)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "sentry not found"}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "test-key", "test")
+	err := c.RevokeSentryToken(context.Background(), "apollo-missing-1700000000", "tok-1")
+	if !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+	if err.Error() != "sentinel API returned status 404: sentry not found" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
+
+func TestMissingAPIKey(t *testing.T) {
+	c := New("http://127.0.0.1:0", "", "test")
+	if _, err := c.CreateSentryToken(context.Background(), "apollo-hospital-1700000000", SentryTokenRequest{}); err == nil {
+		t.Fatal("Expected an error without an API key")
+	}
+}
+
+func TestListSentries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/sentries" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("type") != "apollo" || query.Get("enabled") != "true" {
+			t.Errorf("Unexpected filter %s", r.URL.RawQuery)
+		}
+		if tags := query["tag"]; len(tags) != 2 || tags[0] != "environment:production" || tags[1] != "team:soc" {
+			t.Errorf("Unexpected tag filter %v", tags)
+		}
+
+		page := SentryPage{Sentries: []Sentry{{ID: "apollo-one-1700000000", Name: "one"}}, NextPageToken: "2"}
+		if query.Get("page_token") == "2" {
+			page = SentryPage{Sentries: []Sentry{{ID: "apollo-two-1700000000", Name: "two"}}}
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	enabled := true
+	filter := SentryFilter{
+		Type:    "apollo",
+		Enabled: &enabled,
+		Tags:    map[string]string{"team": "soc", "environment": "production"},
+	}
+
+	c := New(server.URL, "test-key", "test")
+	var names []string
+	for sentry, err := range c.ListSentries(context.Background(), filter) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		names = append(names, sentry.Name)
+	}
+	if len(names) != 2 || names[0] != "one" || names[1] != "two" {
+		t.Errorf("Expected sentries from both pages, got %v", names)
+	}
+}
+
+func TestListSentryLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/sentry-links" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("page_size") != "100" {
+			t.Errorf("Unexpected page size %s", r.URL.RawQuery)
+		}
+
+		page := SentryLinkPage{SentryLinks: []SentryLink{{ID: "sl-1"}}, NextPageToken: "2"}
+		if r.URL.Query().Get("page_token") == "2" {
+			page = SentryLinkPage{SentryLinks: []SentryLink{{ID: "sl-2"}}}
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "test-key", "test")
+	var ids []string
+	for link, err := range c.ListSentryLinks(context.Background()) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		ids = append(ids, link.ID)
+	}
+	if len(ids) != 2 || ids[0] != "sl-1" || ids[1] != "sl-2" {
+		t.Errorf("Expected sentry links from both pages, got %v", ids)
+	}
+}
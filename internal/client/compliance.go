@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Statuses of a compliance control.
+const (
+	ControlStatusCovered = "covered"
+	ControlStatusPartial = "partial"
+	ControlStatusGap     = "gap"
+)
+
+// ComplianceReportRequest selects the framework and scope of a compliance report.
+// An empty scope covers all sentries of the tenant.
+type ComplianceReportRequest struct {
+	Framework string
+	Sector    string
+	SentryIDs []string
+}
+
+// ComplianceControl is the coverage of a single control of a framework.
+type ComplianceControl struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Status    string   `json:"status"`
+	SentryIDs []string `json:"sentry_ids"`
+	Gaps      []string `json:"gaps,omitempty"`
+}
+
+// ComplianceReport maps the controls of a framework to the sentries covering them.
+type ComplianceReport struct {
+	Framework   string              `json:"framework"`
+	GeneratedAt time.Time           `json:"generated_at"`
+	Controls    []ComplianceControl `json:"controls"`
+}
+
+// GetComplianceReport generates a compliance report.
+func (c *Client) GetComplianceReport(ctx context.Context, req ComplianceReportRequest) (*ComplianceReport, error) {
+	query := url.Values{}
+	query.Set("framework", req.Framework)
+	if req.Sector != "" {
+		query.Set("sector", req.Sector)
+	}
+	for _, id := range req.SentryIDs {
+		query.Add("sentry_id", id)
+	}
+
+	var report ComplianceReport
+	if err := c.do(ctx, http.MethodGet, "/v1/compliance/report", query, nil, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ConfigKey describes a key a sentry type understands in its config map.
+type ConfigKey struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Default       string   `json:"default,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+	Description   string   `json:"description,omitempty"`
+}
+
+// GetConfigSchema returns the config keys the server accepts for a sentry type.
+func (c *Client) GetConfigSchema(ctx context.Context, sentryType string) ([]ConfigKey, error) {
+	var result struct {
+		Keys []ConfigKey `json:"keys"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v1/sentry-types/"+url.PathEscape(sentryType)+"/config-schema", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Keys, nil
+}
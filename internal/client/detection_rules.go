@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DetectionRule is a custom detection written in Sigma that runs on the
+// attached sentries.
+type DetectionRule struct {
+	ID        string    `json:"id,omitempty"`
+	Sigma     string    `json:"sigma"`
+	SentryIDs []string  `json:"sentry_ids"`
+	Enabled   bool      `json:"enabled"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateDetectionRule creates a detection rule.
+func (c *Client) CreateDetectionRule(ctx context.Context, rule DetectionRule) (*DetectionRule, error) {
+	var created DetectionRule
+	if err := c.do(ctx, http.MethodPost, "/v1/detection-rules", nil, rule, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetDetectionRule returns the detection rule with the given ID.
+func (c *Client) GetDetectionRule(ctx context.Context, id string) (*DetectionRule, error) {
+	var rule DetectionRule
+	if err := c.do(ctx, http.MethodGet, "/v1/detection-rules/"+url.PathEscape(id), nil, nil, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// UpdateDetectionRule replaces the detection rule with the given ID.
+func (c *Client) UpdateDetectionRule(ctx context.Context, id string, rule DetectionRule) (*DetectionRule, error) {
+	var updated DetectionRule
+	if err := c.do(ctx, http.MethodPut, "/v1/detection-rules/"+url.PathEscape(id), nil, rule, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteDetectionRule deletes the detection rule with the given ID.
+func (c *Client) DeleteDetectionRule(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v1/detection-rules/"+url.PathEscape(id), nil, nil, nil)
+}
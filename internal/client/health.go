@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SentryHealth reports the live operational state of a sentry.
+type SentryHealth struct {
+	LastHeartbeat   time.Time `json:"last_heartbeat"`
+	SensorsTotal    int64     `json:"sensors_total"`
+	SensorsOnline   int64     `json:"sensors_online"`
+	EventsPerMinute float64   `json:"events_per_minute"`
+	ModelVersion    string    `json:"model_version"`
+	LastError       string    `json:"last_error,omitempty"`
+	// HealthScore ranges from 0 (down) to 100 (fully healthy).
+	HealthScore float64 `json:"health_score"`
+}
+
+// GetSentryHealth returns the current health of the sentry with the given ID.
+func (c *Client) GetSentryHealth(ctx context.Context, id string) (*SentryHealth, error) {
+	var health SentryHealth
+	if err := c.do(ctx, http.MethodGet, "/v1/sentries/"+url.PathEscape(id)+"/health", nil, nil, &health); err != nil {
+		return nil, err
+	}
+	return &health, nil
+}
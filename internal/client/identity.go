@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Identity describes the principal the client authenticates as.
+type Identity struct {
+	Principal     string   `json:"principal"`
+	PrincipalType string   `json:"principal_type"`
+	Tenant        string   `json:"tenant"`
+	Roles         []string `json:"roles"`
+	Scopes        []string `json:"scopes"`
+	// KeyExpiresAt is nil for API keys that do not expire.
+	KeyExpiresAt *time.Time `json:"key_expires_at,omitempty"`
+}
+
+// GetCurrentIdentity returns the identity of the configured API key.
+func (c *Client) GetCurrentIdentity(ctx context.Context) (*Identity, error) {
+	var identity Identity
+	if err := c.do(ctx, http.MethodGet, "/v1/identity", nil, nil, &identity); err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// incidentPageSize is the number of incidents requested per page when listing.
+const incidentPageSize = 100
+
+// Incident is a detection raised by a sentry.
+type Incident struct {
+	ID       string `json:"id"`
+	SentryID string `json:"sentry_id"`
+	Sector   string `json:"sector"`
+	Severity string `json:"severity"`
+	Category string `json:"category"`
+	// Techniques are the MITRE ATT&CK technique IDs, e.g. T1059.001.
+	Techniques []string  `json:"techniques"`
+	FirstSeen  time.Time `json:"first_seen"`
+	LastSeen   time.Time `json:"last_seen"`
+	State      string    `json:"state"`
+}
+
+// IncidentFilter narrows down the incidents returned by ListIncidents. Zero
+// values do not filter.
+type IncidentFilter struct {
+	SentryID    string
+	Sector      string
+	MinSeverity string
+	// Since only returns incidents last seen at or after this time.
+	Since time.Time
+}
+
+// query encodes the filter as query parameters.
+func (f IncidentFilter) query() url.Values {
+	query := url.Values{}
+	if f.SentryID != "" {
+		query.Set("sentry_id", f.SentryID)
+	}
+	if f.Sector != "" {
+		query.Set("sector", f.Sector)
+	}
+	if f.MinSeverity != "" {
+		query.Set("min_severity", f.MinSeverity)
+	}
+	if !f.Since.IsZero() {
+		query.Set("since", f.Since.UTC().Format(time.RFC3339))
+	}
+	return query
+}
+
+// IncidentPage is a single page of incidents.
+type IncidentPage struct {
+	Incidents     []Incident `json:"incidents"`
+	NextPageToken string     `json:"next_page_token,omitempty"`
+}
+
+// ListIncidentsPage returns the page of incidents matching filter that starts
+// at pageToken. An empty pageToken requests the first page.
+func (c *Client) ListIncidentsPage(ctx context.Context, filter IncidentFilter, pageToken string) (*IncidentPage, error) {
+	query := filter.query()
+	query.Set("page_size", strconv.Itoa(incidentPageSize))
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
+
+	var page IncidentPage
+	if err := c.do(ctx, http.MethodGet, "/v1/incidents", query, nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// ListIncidents iterates over all incidents matching filter, most recently seen
+// first, fetching further pages as needed. Iteration stops after the first error.
+func (c *Client) ListIncidents(ctx context.Context, filter IncidentFilter) iter.Seq2[Incident, error] {
+	return func(yield func(Incident, error) bool) {
+		pageToken := ""
+		for {
+			page, err := c.ListIncidentsPage(ctx, filter, pageToken)
+			if err != nil {
+				yield(Incident{}, err)
+				return
+			}
+			for _, incident := range page.Incidents {
+				if !yield(incident, nil) {
+					return
+				}
+			}
+			if page.NextPageToken == "" {
+				return
+			}
+			pageToken = page.NextPageToken
+		}
+	}
+}
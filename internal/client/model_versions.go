@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ModelVersion is a published version of the detection model of a sector.
+type ModelVersion struct {
+	Version          string    `json:"version"`
+	Sector           string    `json:"sector"`
+	ReleasedAt       time.Time `json:"released_at"`
+	ChangelogSummary string    `json:"changelog_summary,omitempty"`
+	// Metrics are evaluation metrics such as precision, recall or f1.
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+	// DeprecatedAt is nil for versions that are not deprecated.
+	DeprecatedAt *time.Time `json:"deprecated_at,omitempty"`
+}
+
+// ListModelVersions returns the published model versions of the given sector,
+// or of all sectors when sector is empty, newest first.
+func (c *Client) ListModelVersions(ctx context.Context, sector string) ([]ModelVersion, error) {
+	query := url.Values{}
+	if sector != "" {
+		query.Set("sector", sector)
+	}
+
+	var result struct {
+		ModelVersions []ModelVersion `json:"model_versions"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v1/model-versions", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.ModelVersions, nil
+}
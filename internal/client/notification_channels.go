@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Types of notification channels.
+const (
+	NotificationChannelEmail     = "email"
+	NotificationChannelWebhook   = "webhook"
+	NotificationChannelSlack     = "slack"
+	NotificationChannelPagerDuty = "pagerduty"
+)
+
+// NotificationChannel is a destination for alerts. Exactly one of the settings
+// matching Type is set. Secrets are write-only and never returned by the API.
+type NotificationChannel struct {
+	ID        string                    `json:"id,omitempty"`
+	Name      string                    `json:"name"`
+	Type      string                    `json:"type"`
+	Enabled   bool                      `json:"enabled"`
+	Email     *EmailChannelSettings     `json:"email,omitempty"`
+	Webhook   *WebhookChannelSettings   `json:"webhook,omitempty"`
+	Slack     *SlackChannelSettings     `json:"slack,omitempty"`
+	PagerDuty *PagerDutyChannelSettings `json:"pagerduty,omitempty"`
+}
+
+// EmailChannelSettings configures an email channel.
+type EmailChannelSettings struct {
+	Addresses []string `json:"addresses"`
+}
+
+// WebhookChannelSettings configures a generic webhook. Payloads are signed
+// with an HMAC-SHA256 of SigningSecret.
+type WebhookChannelSettings struct {
+	URL           string            `json:"url"`
+	SigningSecret string            `json:"signing_secret,omitempty"`
+	Headers       map[string]string `json:"headers,omitempty"`
+}
+
+// SlackChannelSettings configures a Slack incoming webhook.
+type SlackChannelSettings struct {
+	WebhookURL string `json:"webhook_url,omitempty"`
+	Channel    string `json:"channel,omitempty"`
+}
+
+// PagerDutyChannelSettings configures a PagerDuty Events API v2 integration.
+type PagerDutyChannelSettings struct {
+	RoutingKey string `json:"routing_key,omitempty"`
+}
+
+// NotificationTestResult reports the outcome of a test notification.
+type NotificationTestResult struct {
+	Delivered bool   `json:"delivered"`
+	Detail    string `json:"detail,omitempty"`
+}
+
+// CreateNotificationChannel creates a notification channel.
+func (c *Client) CreateNotificationChannel(ctx context.Context, channel NotificationChannel) (*NotificationChannel, error) {
+	var created NotificationChannel
+	if err := c.do(ctx, http.MethodPost, "/v1/notification-channels", nil, channel, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetNotificationChannel returns the notification channel with the given ID.
+func (c *Client) GetNotificationChannel(ctx context.Context, id string) (*NotificationChannel, error) {
+	var channel NotificationChannel
+	if err := c.do(ctx, http.MethodGet, "/v1/notification-channels/"+url.PathEscape(id), nil, nil, &channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// UpdateNotificationChannel replaces the notification channel with the given ID.
+func (c *Client) UpdateNotificationChannel(ctx context.Context, id string, channel NotificationChannel) (*NotificationChannel, error) {
+	var updated NotificationChannel
+	if err := c.do(ctx, http.MethodPut, "/v1/notification-channels/"+url.PathEscape(id), nil, channel, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteNotificationChannel deletes the notification channel with the given ID.
+func (c *Client) DeleteNotificationChannel(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v1/notification-channels/"+url.PathEscape(id), nil, nil, nil)
+}
+
+// TestNotificationChannel sends a test notification through the channel.
+func (c *Client) TestNotificationChannel(ctx context.Context, id, message string) (*NotificationTestResult, error) {
+	body := struct {
+		Message string `json:"message,omitempty"`
+	}{Message: message}
+
+	var result NotificationTestResult
+	if err := c.do(ctx, http.MethodPost, "/v1/notification-channels/"+url.PathEscape(id)+"/test", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
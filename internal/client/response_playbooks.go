@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ResponsePlaybook runs automated response steps when one of its alert rules
+// fires or one of its sentries detects an incident.
+type ResponsePlaybook struct {
+	ID           string         `json:"id,omitempty"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description,omitempty"`
+	Enabled      bool           `json:"enabled"`
+	AlertRuleIDs []string       `json:"alert_rule_ids,omitempty"`
+	SentryIDs    []string       `json:"sentry_ids,omitempty"`
+	Steps        []PlaybookStep `json:"steps"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+// PlaybookStep is a single action of a response playbook. A step starts once
+// all steps it depends on have finished.
+type PlaybookStep struct {
+	ID               string            `json:"id"`
+	Action           string            `json:"action"`
+	Parameters       map[string]string `json:"parameters,omitempty"`
+	DependsOn        []string          `json:"depends_on,omitempty"`
+	RunIf            string            `json:"run_if"`
+	MinSeverity      string            `json:"min_severity,omitempty"`
+	RequiresApproval bool              `json:"requires_approval"`
+	Approvers        []string          `json:"approvers,omitempty"`
+	Timeout          string            `json:"timeout"`
+}
+
+// CreateResponsePlaybook creates a response playbook.
+func (c *Client) CreateResponsePlaybook(ctx context.Context, playbook ResponsePlaybook) (*ResponsePlaybook, error) {
+	var created ResponsePlaybook
+	if err := c.do(ctx, http.MethodPost, "/v1/response-playbooks", nil, playbook, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetResponsePlaybook returns the response playbook with the given ID.
+func (c *Client) GetResponsePlaybook(ctx context.Context, id string) (*ResponsePlaybook, error) {
+	var playbook ResponsePlaybook
+	if err := c.do(ctx, http.MethodGet, "/v1/response-playbooks/"+url.PathEscape(id), nil, nil, &playbook); err != nil {
+		return nil, err
+	}
+	return &playbook, nil
+}
+
+// UpdateResponsePlaybook replaces the response playbook with the given ID.
+func (c *Client) UpdateResponsePlaybook(ctx context.Context, id string, playbook ResponsePlaybook) (*ResponsePlaybook, error) {
+	var updated ResponsePlaybook
+	if err := c.do(ctx, http.MethodPut, "/v1/response-playbooks/"+url.PathEscape(id), nil, playbook, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteResponsePlaybook deletes the response playbook with the given ID.
+func (c *Client) DeleteResponsePlaybook(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v1/response-playbooks/"+url.PathEscape(id), nil, nil, nil)
+}
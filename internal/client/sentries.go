@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// sentryPageSize is the number of sentries requested per page when listing.
+const sentryPageSize = 100
+
+// Sentry is a sentry as exchanged with the Sentinel API. SecretConfig is only
+// ever sent, the Sentinel API never returns secrets.
+type Sentry struct {
+	ID                     string            `json:"id,omitempty"`
+	Type                   string            `json:"type"`
+	Name                   string            `json:"name"`
+	Description            string            `json:"description,omitempty"`
+	Sector                 string            `json:"sector"`
+	Status                 string            `json:"status"`
+	Enabled                bool              `json:"enabled"`
+	DeletionProtection     bool              `json:"deletion_protection"`
+	Config                 map[string]string `json:"config,omitempty"`
+	SecretConfig           map[string]string `json:"secret_config,omitempty"`
+	NotificationChannelIDs []string          `json:"notification_channel_ids,omitempty"`
+	Tags                   map[string]string `json:"tags,omitempty"`
+	UpdatedAt              time.Time         `json:"updated_at"`
+}
+
+// SentryFilter narrows down the sentries returned by ListSentries. Zero values
+// do not filter.
+type SentryFilter struct {
+	Type       string
+	Sector     string
+	Status     string
+	Enabled    *bool
+	NamePrefix string
+	Tags       map[string]string
+}
+
+// query encodes the filter as query parameters. Tags are sent as repeated
+// tag=key:value parameters and all of them must match.
+func (f SentryFilter) query() url.Values {
+	query := url.Values{}
+	if f.Type != "" {
+		query.Set("type", f.Type)
+	}
+	if f.Sector != "" {
+		query.Set("sector", f.Sector)
+	}
+	if f.Status != "" {
+		query.Set("status", f.Status)
+	}
+	if f.Enabled != nil {
+		query.Set("enabled", strconv.FormatBool(*f.Enabled))
+	}
+	if f.NamePrefix != "" {
+		query.Set("name_prefix", f.NamePrefix)
+	}
+
+	keys := make([]string, 0, len(f.Tags))
+	for key := range f.Tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		query.Add("tag", key+":"+f.Tags[key])
+	}
+
+	return query
+}
+
+// SentryPage is a single page of sentries.
+type SentryPage struct {
+	Sentries      []Sentry `json:"sentries"`
+	NextPageToken string   `json:"next_page_token,omitempty"`
+}
+
+// ListSentriesPage returns the page of sentries matching filter that starts at
+// pageToken. An empty pageToken requests the first page.
+func (c *Client) ListSentriesPage(ctx context.Context, filter SentryFilter, pageToken string) (*SentryPage, error) {
+	query := filter.query()
+	query.Set("page_size", strconv.Itoa(sentryPageSize))
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
+
+	var page SentryPage
+	if err := c.do(ctx, http.MethodGet, "/v1/sentries", query, nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// ListSentries iterates over all sentries matching filter, fetching further
+// pages as needed. Iteration stops after the first error.
+func (c *Client) ListSentries(ctx context.Context, filter SentryFilter) iter.Seq2[Sentry, error] {
+	return func(yield func(Sentry, error) bool) {
+		pageToken := ""
+		for {
+			page, err := c.ListSentriesPage(ctx, filter, pageToken)
+			if err != nil {
+				yield(Sentry{}, err)
+				return
+			}
+			for _, sentry := range page.Sentries {
+				if !yield(sentry, nil) {
+					return
+				}
+			}
+			if page.NextPageToken == "" {
+				return
+			}
+			pageToken = page.NextPageToken
+		}
+	}
+}
+
+// GetSentry returns the sentry with the given ID.
+func (c *Client) GetSentry(ctx context.Context, id string) (*Sentry, error) {
+	var sentry Sentry
+	if err := c.do(ctx, http.MethodGet, "/v1/sentries/"+url.PathEscape(id), nil, nil, &sentry); err != nil {
+		return nil, err
+	}
+	return &sentry, nil
+}
+
+// CreateSentry creates a sentry. The Sentinel API assigns its ID.
+func (c *Client) CreateSentry(ctx context.Context, sentry Sentry) (*Sentry, error) {
+	var created Sentry
+	if err := c.do(ctx, http.MethodPost, "/v1/sentries", nil, sentry, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateSentry replaces the sentry with the given ID.
+func (c *Client) UpdateSentry(ctx context.Context, id string, sentry Sentry) (*Sentry, error) {
+	var updated Sentry
+	if err := c.do(ctx, http.MethodPut, "/v1/sentries/"+url.PathEscape(id), nil, sentry, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteSentry deletes the sentry with the given ID. The Sentinel API refuses
+// to delete sentries with deletion protection enabled.
+func (c *Client) DeleteSentry(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v1/sentries/"+url.PathEscape(id), nil, nil, nil)
+}
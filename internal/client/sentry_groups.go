@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SentryGroup is a set of sentries that share default settings. The members
+// are either listed explicitly or selected by sector and tags.
+type SentryGroup struct {
+	ID          string              `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Selector    SentryGroupSelector `json:"selector"`
+	Defaults    SentryGroupDefaults `json:"defaults"`
+	// MemberIDs is resolved by the Sentinel API from the selector and ignored
+	// when sent.
+	MemberIDs []string  `json:"member_ids,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SentryGroupSelector selects the members of a sentry group: either SentryIDs,
+// or the sentries matching Sector and all of Tags.
+type SentryGroupSelector struct {
+	SentryIDs []string          `json:"sentry_ids,omitempty"`
+	Sector    string            `json:"sector,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+// SentryGroupDefaults are settings that member sentries inherit unless they
+// set them themselves. Zero values are not inherited.
+type SentryGroupDefaults struct {
+	AlertThreshold     string              `json:"alert_threshold,omitempty"`
+	AlertEmails        []string            `json:"alert_emails,omitempty"`
+	MaintenanceWindows []MaintenanceWindow `json:"maintenance_windows,omitempty"`
+	ModelChannel       string              `json:"model_channel,omitempty"`
+}
+
+// MaintenanceWindow is a recurring period in which sentries suppress alerts
+// and may be updated to a new model version.
+type MaintenanceWindow struct {
+	Days      []string `json:"days"`
+	StartTime string   `json:"start_time"`
+	Duration  string   `json:"duration"`
+	Timezone  string   `json:"timezone"`
+}
+
+// CreateSentryGroup creates a sentry group.
+func (c *Client) CreateSentryGroup(ctx context.Context, group SentryGroup) (*SentryGroup, error) {
+	var created SentryGroup
+	if err := c.do(ctx, http.MethodPost, "/v1/sentry-groups", nil, group, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetSentryGroup returns the sentry group with the given ID.
+func (c *Client) GetSentryGroup(ctx context.Context, id string) (*SentryGroup, error) {
+	var group SentryGroup
+	if err := c.do(ctx, http.MethodGet, "/v1/sentry-groups/"+url.PathEscape(id), nil, nil, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// UpdateSentryGroup replaces the sentry group with the given ID.
+func (c *Client) UpdateSentryGroup(ctx context.Context, id string, group SentryGroup) (*SentryGroup, error) {
+	var updated SentryGroup
+	if err := c.do(ctx, http.MethodPut, "/v1/sentry-groups/"+url.PathEscape(id), nil, group, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteSentryGroup deletes the sentry group with the given ID. Member
+// sentries are kept and stop inheriting the group defaults.
+func (c *Client) DeleteSentryGroup(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v1/sentry-groups/"+url.PathEscape(id), nil, nil, nil)
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SentryLink is a directed intelligence-sharing relationship: the source
+// sentry shares events of the given categories with the target sentry. The
+// Sentinel API rejects links that would close a cycle with a conflict error,
+// and links between tenants without a sharing agreement as forbidden.
+type SentryLink struct {
+	ID             string   `json:"id,omitempty"`
+	SourceSentryID string   `json:"source_sentry_id"`
+	TargetSentryID string   `json:"target_sentry_id"`
+	Categories     []string `json:"categories"`
+	// SharingLevel is the Traffic Light Protocol level of the shared events,
+	// e.g. amber.
+	SharingLevel  string    `json:"sharing_level"`
+	LatencyBudget string    `json:"latency_budget"`
+	Enabled       bool      `json:"enabled"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// sentryLinkPageSize is the number of sentry links requested per page when listing.
+const sentryLinkPageSize = 100
+
+// SentryLinkPage is a single page of sentry links.
+type SentryLinkPage struct {
+	SentryLinks   []SentryLink `json:"sentry_links"`
+	NextPageToken string       `json:"next_page_token,omitempty"`
+}
+
+// ListSentryLinksPage returns the page of sentry links of the tenant that
+// starts at pageToken. An empty pageToken requests the first page.
+func (c *Client) ListSentryLinksPage(ctx context.Context, pageToken string) (*SentryLinkPage, error) {
+	query := url.Values{}
+	query.Set("page_size", strconv.Itoa(sentryLinkPageSize))
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
+
+	var page SentryLinkPage
+	if err := c.do(ctx, http.MethodGet, "/v1/sentry-links", query, nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// ListSentryLinks iterates over all sentry links of the tenant, fetching
+// further pages as needed. Iteration stops after the first error.
+func (c *Client) ListSentryLinks(ctx context.Context) iter.Seq2[SentryLink, error] {
+	return func(yield func(SentryLink, error) bool) {
+		pageToken := ""
+		for {
+			page, err := c.ListSentryLinksPage(ctx, pageToken)
+			if err != nil {
+				yield(SentryLink{}, err)
+				return
+			}
+			for _, link := range page.SentryLinks {
+				if !yield(link, nil) {
+					return
+				}
+			}
+			if page.NextPageToken == "" {
+				return
+			}
+			pageToken = page.NextPageToken
+		}
+	}
+}
+
+// CreateSentryLink creates a sentry link.
+func (c *Client) CreateSentryLink(ctx context.Context, link SentryLink) (*SentryLink, error) {
+	var created SentryLink
+	if err := c.do(ctx, http.MethodPost, "/v1/sentry-links", nil, link, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetSentryLink returns the sentry link with the given ID.
+func (c *Client) GetSentryLink(ctx context.Context, id string) (*SentryLink, error) {
+	var link SentryLink
+	if err := c.do(ctx, http.MethodGet, "/v1/sentry-links/"+url.PathEscape(id), nil, nil, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// UpdateSentryLink replaces the sentry link with the given ID. The source and
+// target sentries of a link cannot be changed.
+func (c *Client) UpdateSentryLink(ctx context.Context, id string, link SentryLink) (*SentryLink, error) {
+	var updated SentryLink
+	if err := c.do(ctx, http.MethodPut, "/v1/sentry-links/"+url.PathEscape(id), nil, link, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteSentryLink deletes the sentry link with the given ID.
+func (c *Client) DeleteSentryLink(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v1/sentry-links/"+url.PathEscape(id), nil, nil, nil)
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ThreatLevel is the current threat level of a sector.
+type ThreatLevel struct {
+	Sector    string    `json:"sector"`
+	Level     string    `json:"level"`
+	Advisory  string    `json:"advisory,omitempty"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// ListThreatLevels returns the current threat level of the given sector, or of
+// all sectors when sector is empty.
+func (c *Client) ListThreatLevels(ctx context.Context, sector string) ([]ThreatLevel, error) {
+	query := url.Values{}
+	if sector != "" {
+		query.Set("sector", sector)
+	}
+
+	var result struct {
+		ThreatLevels []ThreatLevel `json:"threat_levels"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v1/threat-levels", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.ThreatLevels, nil
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SentryTokenRequest describes a scoped access token to mint for a sentry.
+type SentryTokenRequest struct {
+	TTLSeconds int64    `json:"ttl_seconds"`
+	Scopes     []string `json:"scopes,omitempty"`
+}
+
+// SentryToken is a short-lived access token scoped to a single sentry.
+type SentryToken struct {
+	ID        string    `json:"id"`
+	Token     string    `json:"token"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreateSentryToken mints an access token for the sentry.
+func (c *Client) CreateSentryToken(ctx context.Context, sentryID string, req SentryTokenRequest) (*SentryToken, error) {
+	var token SentryToken
+	if err := c.do(ctx, http.MethodPost, "/v1/sentries/"+url.PathEscape(sentryID)+"/tokens", nil, req, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// RenewSentryToken extends the lifetime of an access token by its original TTL.
+func (c *Client) RenewSentryToken(ctx context.Context, sentryID, tokenID string) (*SentryToken, error) {
+	var token SentryToken
+	path := "/v1/sentries/" + url.PathEscape(sentryID) + "/tokens/" + url.PathEscape(tokenID) + "/renew"
+	if err := c.do(ctx, http.MethodPost, path, nil, nil, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// RevokeSentryToken revokes an access token before it expires.
+func (c *Client) RevokeSentryToken(ctx context.Context, sentryID, tokenID string) error {
+	path := "/v1/sentries/" + url.PathEscape(sentryID) + "/tokens/" + url.PathEscape(tokenID)
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
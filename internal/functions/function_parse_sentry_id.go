@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/cywf/sentinel-provider/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ParseSentryIDFunction{}
+
+// parsedSentryIDAttributeTypes are the attribute types of the object returned by parse_sentry_id.
+var parsedSentryIDAttributeTypes = map[string]attr.Type{
+	"sentry_type": types.StringType,
+	"name":        types.StringType,
+	"tenant":      types.StringType,
+}
+
+// NewParseSentryIDFunction is a helper function to simplify the provider implementation.
+func NewParseSentryIDFunction() function.Function {
+	return &ParseSentryIDFunction{}
+}
+
+// ParseSentryIDFunction splits a sentry ID into its sentry type, name and tenant.
+type ParseSentryIDFunction struct{}
+
+// Metadata returns the function name.
+func (f *ParseSentryIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_sentry_id"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ParseSentryIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a sentry ID",
+		Description: "Parses a sentry ID of the form [<tenant>/]<type>-<name>-<created> into an object with the " +
+			"sentry_type, name and tenant attributes. tenant is null when the ID is not tenant qualified.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The sentry ID to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedSentryIDAttributeTypes,
+		},
+	}
+}
+
+// Run parses the sentry ID.
+func (f *ParseSentryIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := resources.ParseSentryID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	tenant := types.StringNull()
+	if parsed.Tenant != "" {
+		tenant = types.StringValue(parsed.Tenant)
+	}
+
+	result, diags := types.ObjectValue(parsedSentryIDAttributeTypes, map[string]attr.Value{
+		"sentry_type": types.StringValue(parsed.Type),
+		"name":        types.StringValue(parsed.Name),
+		"tenant":      tenant,
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
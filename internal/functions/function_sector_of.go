@@ -0,0 +1,58 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &SectorOfFunction{}
+
+// NewSectorOfFunction is a helper function to simplify the provider implementation.
+func NewSectorOfFunction() function.Function {
+	return &SectorOfFunction{}
+}
+
+// SectorOfFunction maps a sentry type to the critical infrastructure sector it protects.
+type SectorOfFunction struct{}
+
+// Metadata returns the function name.
+func (f *SectorOfFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sector_of"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *SectorOfFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Look up the sector of a sentry type",
+		Description: "Returns the canonical name of the critical infrastructure sector protected by a sentry type, " +
+			"e.g. \"Healthcare\" for \"apollo\". The type may also be given as the resource type, e.g. \"sentinel_apollo\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "sentry_type",
+				Description: "The sentry type, e.g. \"apollo\" or \"sentinel_apollo\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run looks up the sector in the sentry catalog.
+func (f *SectorOfFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sentryType string
+	resp.Error = req.Arguments.Get(ctx, &sentryType)
+	if resp.Error != nil {
+		return
+	}
+
+	sentry, ok := catalog.LookupResourceType(sentryType)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unknown sentry type %q.", sentryType))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, sentry.Sector)
+}
@@ -0,0 +1,58 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &SentryForSectorFunction{}
+
+// NewSentryForSectorFunction is a helper function to simplify the provider implementation.
+func NewSentryForSectorFunction() function.Function {
+	return &SentryForSectorFunction{}
+}
+
+// SentryForSectorFunction maps a critical infrastructure sector to the sentry type protecting it.
+type SentryForSectorFunction struct{}
+
+// Metadata returns the function name.
+func (f *SentryForSectorFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sentry_for_sector"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *SentryForSectorFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Look up the sentry type of a sector",
+		Description: "Returns the sentry type protecting a critical infrastructure sector, e.g. \"apollo\" for " +
+			"\"Healthcare\". The sector must be given by its canonical name.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "sector",
+				Description: "The canonical sector name, e.g. \"Healthcare\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run looks up the sentry type in the sentry catalog.
+func (f *SentryForSectorFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sector string
+	resp.Error = req.Arguments.Get(ctx, &sector)
+	if resp.Error != nil {
+		return
+	}
+
+	sentry, ok := catalog.LookupSector(sector)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unknown sector %q.", sector))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, sentry.Type)
+}
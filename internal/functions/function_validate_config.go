@@ -0,0 +1,76 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ValidateConfigFunction{}
+
+// NewValidateConfigFunction is a helper function to simplify the provider implementation.
+func NewValidateConfigFunction() function.Function {
+	return &ValidateConfigFunction{}
+}
+
+// ValidateConfigFunction validates and normalizes a sentry config map.
+type ValidateConfigFunction struct{}
+
+// Metadata returns the function name.
+func (f *ValidateConfigFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_config"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ValidateConfigFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate and normalize a sentry config map",
+		Description: "Checks a config map against the keys supported by a sentry type, applying the same rules as " +
+			"the sentry resources. Returns the map with defaults filled in, or fails listing every problem a sentry " +
+			"resource would reject. Unsupported keys, which the resources only warn about, are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "sentry_type",
+				Description: "The sentry type, e.g. \"apollo\" or \"sentinel_apollo\".",
+			},
+			function.MapParameter{
+				Name:        "config",
+				Description: "The config map to validate.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run validates the config map.
+func (f *ValidateConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sentryType string
+	var config map[string]string
+	resp.Error = req.Arguments.Get(ctx, &sentryType, &config)
+	if resp.Error != nil {
+		return
+	}
+
+	sentry, ok := catalog.LookupResourceType(sentryType)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unknown sentry type %q.", sentryType))
+		return
+	}
+
+	normalized, problems := resources.NormalizeSentryConfig(sentry, config)
+	if len(problems) > 0 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid %s config: %s.", sentry.Name, strings.Join(problems, "; ")))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
@@ -0,0 +1,129 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction runs f with the given arguments and returns its result.
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	definition := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definition)
+
+	result, err := definition.Definition.Return.NewResultData(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp := &function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseSentryIDFunction(t *testing.T) {
+	result, err := runFunction(t, NewParseSentryIDFunction(), types.StringValue("acme/apollo-main-hospital-1700000000"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := types.ObjectValueMust(parsedSentryIDAttributeTypes, map[string]attr.Value{
+		"sentry_type": types.StringValue("apollo"),
+		"name":        types.StringValue("main-hospital"),
+		"tenant":      types.StringValue("acme"),
+	})
+	if !result.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+
+	if _, err := runFunction(t, NewParseSentryIDFunction(), types.StringValue("not-an-id")); err == nil {
+		t.Error("Expected an error for an invalid sentry ID")
+	}
+}
+
+func TestSectorOfFunction(t *testing.T) {
+	for _, sentryType := range []string{"apollo", "sentinel_apollo"} {
+		result, err := runFunction(t, NewSectorOfFunction(), types.StringValue(sentryType))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !result.Equal(types.StringValue("Healthcare")) {
+			t.Errorf("Expected sector_of(%q) to be \"Healthcare\", got %s", sentryType, result)
+		}
+	}
+
+	if _, err := runFunction(t, NewSectorOfFunction(), types.StringValue("zeus")); err == nil {
+		t.Error("Expected an error for an unknown sentry type")
+	}
+}
+
+func TestSentryForSectorFunction(t *testing.T) {
+	result, err := runFunction(t, NewSentryForSectorFunction(), types.StringValue("Nuclear Reactors, Materials, and Waste"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !result.Equal(types.StringValue("shiva")) {
+		t.Errorf("Expected \"shiva\", got %s", result)
+	}
+
+	if _, err := runFunction(t, NewSentryForSectorFunction(), types.StringValue("Space")); err == nil {
+		t.Error("Expected an error for an unknown sector")
+	}
+}
+
+func TestValidateConfigFunction(t *testing.T) {
+	config := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"fraud_detection": types.StringValue("advanced"),
+		"region":          types.StringValue("us-east-1"),
+		"siem_forwarder":  types.StringValue("splunk"),
+	})
+
+	result, err := runFunction(t, NewValidateConfigFunction(), types.StringValue("tyche"), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"fraud_detection":        types.StringValue("advanced"),
+		"region":                 types.StringValue("us-east-1"),
+		"siem_forwarder":         types.StringValue("splunk"),
+		"audit_logging":          types.StringValue("enabled"),
+		"transaction_monitoring": types.StringValue("enabled"),
+	})
+	if !result.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+}
+
+func TestValidateConfigFunctionInvalid(t *testing.T) {
+	config := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"fraud_detection": types.StringValue("psychic"),
+		"Region":          types.StringValue("us-east-1"),
+		"Threat-Level":    types.StringValue("high"),
+		"grid_region":     types.StringValue("northeast"),
+	})
+
+	_, err := runFunction(t, NewValidateConfigFunction(), types.StringValue("tyche"), config)
+	if err == nil {
+		t.Fatal("Expected an error for an invalid config")
+	}
+
+	for _, key := range []string{"fraud_detection", "Region", "Threat-Level"} {
+		if !strings.Contains(err.Text, key) {
+			t.Errorf("Expected the error to mention %q, got: %s", key, err.Text)
+		}
+	}
+	// Unsupported keys are only warned about by the resources.
+	if strings.Contains(err.Text, "grid_region") {
+		t.Errorf("Expected the unsupported key not to be an error, got: %s", err.Text)
+	}
+}
@@ -0,0 +1,105 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &SendTestNotificationAction{}
+	_ action.ActionWithConfigure = &SendTestNotificationAction{}
+)
+
+// defaultTestNotificationMessage is the message sent when message is omitted.
+const defaultTestNotificationMessage = "Test notification from the Sentinel Terraform provider."
+
+// NewSendTestNotificationAction is a helper function to simplify the provider implementation.
+func NewSendTestNotificationAction() action.Action {
+	return &SendTestNotificationAction{}
+}
+
+// SendTestNotificationAction sends a test notification through a notification channel.
+type SendTestNotificationAction struct {
+	providerData *ProviderData
+}
+
+// SendTestNotificationModel describes the send test notification action data model.
+type SendTestNotificationModel struct {
+	ChannelID types.String `tfsdk:"channel_id"`
+	Message   types.String `tfsdk:"message"`
+}
+
+// Metadata returns the action type name.
+func (a *SendTestNotificationAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_send_test_notification"
+}
+
+// Schema defines the schema for the action.
+func (a *SendTestNotificationAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a test notification through a notification channel and fails when it is not delivered, " +
+			"for example after a sentinel_notification_channel is created or its secret is rotated.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the notification channel.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description: "The message of the test notification.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configuration to the action.
+func (a *SendTestNotificationAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.providerData = configureActionProviderData(req, resp)
+}
+
+// Invoke sends the test notification.
+func (a *SendTestNotificationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data SendTestNotificationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := a.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := data.ChannelID.ValueString()
+	message := defaultTestNotificationMessage
+	if !data.Message.IsNull() {
+		message = data.Message.ValueString()
+	}
+
+	tflog.Info(ctx, "Sending test notification", map[string]interface{}{
+		"channel_id": channelID,
+	})
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Sending test notification through channel " + channelID})
+
+	result, err := apiClient.TestNotificationChannel(ctx, channelID, message)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Sending Test Notification", "Could not send a test notification through channel "+channelID+": "+err.Error())
+		return
+	}
+	if !result.Delivered {
+		resp.Diagnostics.AddError("Test Notification Not Delivered", "The test notification through channel "+channelID+" was not delivered: "+result.Detail)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Test notification delivered through channel " + channelID})
+}
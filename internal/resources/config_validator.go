@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.Map = sentryConfigValidator{}
+
+// sentryConfigValidator applies the sentry config rules to a sentry's config
+// map. Problems are errors, except for keys the catalog does not know, which
+// are only warned about so that config remains usable as an escape hatch.
+type sentryConfigValidator struct {
+	sentry catalog.Sentry
+}
+
+// Description describes the validation in plain text formatting.
+func (v sentryConfigValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("config keys and values must match the keys supported by %s sentries", v.sentry.Name)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sentryConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateMap performs the validation.
+func (v sentryConfigValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	config := make(map[string]string)
+	for key, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		config[key] = value.ValueString()
+	}
+
+	_, problems := checkSentryConfig(v.sentry, config)
+	for _, problem := range problems {
+		if problem.Unknown {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path.AtMapKey(problem.Key),
+				problem.Summary,
+				fmt.Sprintf("The Sentinel API may ignore this key: %s.", problem.Message),
+			)
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtMapKey(problem.Key),
+			problem.Summary,
+			fmt.Sprintf("The config is invalid: %s.", problem.Message),
+		)
+	}
+}
+
+// configProblem describes a config entry that breaks the sentry config rules.
+type configProblem struct {
+	// Key is the key as given in the config map.
+	Key string
+	// Unknown is set when the sentry does not understand the key at all. Such
+	// keys are passed to the Sentinel API unchanged.
+	Unknown bool
+	// Summary names the broken rule.
+	Summary string
+	// Message describes the problem.
+	Message string
+}
+
+// checkSentryConfig applies the config rules shared by the sentry resources and
+// the validate_config function:
+//
+//   - keys superseded by a typed attribute are rejected in any spelling;
+//   - known keys must use their canonical spelling, because the Sentinel API
+//     returns them canonically and any other spelling would never converge;
+//   - values of known keys must match the key's type and allowed values;
+//   - unknown keys are reported but carried over unchanged.
+//
+// It returns the config with the catalog defaults filled in, along with every
+// problem found ordered by key.
+func checkSentryConfig(sentry catalog.Sentry, config map[string]string) (map[string]string, []configProblem) {
+	var problems []configProblem
+
+	checked := make(map[string]string, len(config))
+	for key, value := range config {
+		canonical := catalog.CanonicalConfigKey(key)
+		if slices.Contains(typedConfigKeys, canonical) {
+			problems = append(problems, configProblem{
+				Key:     key,
+				Summary: "Typed Config Key",
+				Message: fmt.Sprintf("config key %q is set through a typed sentry attribute instead", key),
+			})
+			continue
+		}
+		if _, known := sentry.ConfigKey(canonical); known && canonical != key {
+			problems = append(problems, configProblem{
+				Key:     key,
+				Summary: "Non-Canonical Config Key",
+				Message: fmt.Sprintf("config key %q must be spelled %q", key, canonical),
+			})
+			continue
+		}
+		checked[key] = value
+	}
+
+	normalized, issues := sentry.NormalizeConfig(checked)
+	for _, issue := range issues {
+		problem := configProblem{Key: issue.Key, Unknown: issue.Unknown, Summary: "Invalid Config Value", Message: issue.Message}
+		if issue.Unknown {
+			problem.Summary = "Unsupported Config Key"
+		}
+		problems = append(problems, problem)
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].Key < problems[j].Key })
+	return normalized, problems
+}
+
+// NormalizeSentryConfig applies the sentry config rules to a config map. It
+// returns the config with the catalog defaults filled in and unknown keys
+// carried over, as the sentry resources would send it, or the problems the
+// sentry resources reject it for.
+func NormalizeSentryConfig(sentry catalog.Sentry, config map[string]string) (map[string]string, []string) {
+	normalized, problems := checkSentryConfig(sentry, config)
+
+	var messages []string
+	for _, problem := range problems {
+		if !problem.Unknown {
+			messages = append(messages, problem.Message)
+		}
+	}
+	if len(messages) > 0 {
+		return nil, messages
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSentryConfigRules(t *testing.T) {
+	ctx := context.Background()
+	tyche, _ := catalog.Lookup("tyche")
+
+	testCases := map[string]struct {
+		key           string
+		value         string
+		expectError   bool
+		expectWarning bool
+	}{
+		"supported key":            {key: "fraud_detection", value: "advanced"},
+		"invalid value":            {key: "fraud_detection", value: "psychic", expectError: true},
+		"non-canonical spelling":   {key: "Fraud-Detection", value: "advanced", expectError: true},
+		"typed key":                {key: "threat_level", value: "high", expectError: true},
+		"typed key mixed case":     {key: "Threat_Level", value: "high", expectError: true},
+		"typed key with hyphens":   {key: "alert-threshold", value: "high", expectError: true},
+		"unsupported key":          {key: "grid_region", value: "northeast", expectWarning: true},
+		"unsupported mixed case":   {key: "SIEM_Forwarder", value: "splunk", expectWarning: true},
+		"common key":               {key: "audit_logging", value: "disabled"},
+		"invalid common key value": {key: "audit_logging", value: "sometimes", expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.MapResponse{}
+			sentryConfigValidator{sentry: tyche}.ValidateMap(ctx, validator.MapRequest{
+				Path: path.Root("config"),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+					testCase.key: types.StringValue(testCase.value),
+				}),
+			}, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("Expected resource error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+			if (resp.Diagnostics.WarningsCount() > 0) != testCase.expectWarning {
+				t.Errorf("Expected resource warning %t, got %v", testCase.expectWarning, resp.Diagnostics)
+			}
+
+			// validate_config must reject exactly what the resources reject.
+			normalized, problems := NormalizeSentryConfig(tyche, map[string]string{testCase.key: testCase.value})
+			if (len(problems) > 0) != testCase.expectError {
+				t.Errorf("Expected validate_config error %t, got %v", testCase.expectError, problems)
+			}
+			if !testCase.expectError && normalized[testCase.key] != testCase.value {
+				t.Errorf("Expected %s to be kept, got %v", testCase.key, normalized)
+			}
+		})
+	}
+}
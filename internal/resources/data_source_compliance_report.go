@@ -0,0 +1,238 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ComplianceReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &ComplianceReportDataSource{}
+)
+
+// NewComplianceReportDataSource is a helper function to simplify the provider implementation.
+func NewComplianceReportDataSource() datasource.DataSource {
+	return &ComplianceReportDataSource{}
+}
+
+// ComplianceReportDataSource reports which sentries cover the controls of a compliance framework.
+type ComplianceReportDataSource struct {
+	providerData *ProviderData
+}
+
+// ComplianceReportDataSourceModel describes the compliance report data source data model.
+type ComplianceReportDataSourceModel struct {
+	Framework       types.String  `tfsdk:"framework"`
+	Sector          types.String  `tfsdk:"sector"`
+	SentryIDs       types.List    `tfsdk:"sentry_ids"`
+	GeneratedAt     types.String  `tfsdk:"generated_at"`
+	ControlsTotal   types.Int64   `tfsdk:"controls_total"`
+	ControlsCovered types.Int64   `tfsdk:"controls_covered"`
+	CoveragePercent types.Float64 `tfsdk:"coverage_percent"`
+	Controls        types.List    `tfsdk:"controls"`
+}
+
+// ComplianceControlModel describes a control in the list returned by the compliance report data source.
+type ComplianceControlModel struct {
+	ID        types.String `tfsdk:"id"`
+	Title     types.String `tfsdk:"title"`
+	Status    types.String `tfsdk:"status"`
+	SentryIDs types.List   `tfsdk:"sentry_ids"`
+	Gaps      types.List   `tfsdk:"gaps"`
+}
+
+// AttributeTypes returns the attribute types of a compliance control object.
+func (m ComplianceControlModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"title":      types.StringType,
+		"status":     types.StringType,
+		"sentry_ids": types.ListType{ElemType: types.StringType},
+		"gaps":       types.ListType{ElemType: types.StringType},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *ComplianceReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compliance_report"
+}
+
+// Schema defines the schema for the data source.
+func (d *ComplianceReportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports, for a compliance framework and scope, which sentries cover each control and where the gaps are. " +
+			"The report is generated on every plan.",
+		Attributes: map[string]schema.Attribute{
+			"framework": schema.StringAttribute{
+				Description: "The compliance framework, e.g. hipaa, pci-dss or nerc-cip.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"sector": schema.StringAttribute{
+				Description: "Only consider the sentries of this sector.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(catalog.Sectors()...),
+				},
+			},
+			"sentry_ids": schema.ListAttribute{
+				Description: "Only consider these sentries. When neither sector nor sentry_ids is set, all sentries are considered.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"generated_at": schema.StringAttribute{
+				Description: "Time the report was generated (RFC3339 format).",
+				Computed:    true,
+			},
+			"controls_total": schema.Int64Attribute{
+				Description: "Number of controls in the framework.",
+				Computed:    true,
+			},
+			"controls_covered": schema.Int64Attribute{
+				Description: "Number of fully covered controls.",
+				Computed:    true,
+			},
+			"coverage_percent": schema.Float64Attribute{
+				Description: "Percentage of fully covered controls.",
+				Computed:    true,
+			},
+			"controls": schema.ListNestedAttribute{
+				Description: "Coverage of each control of the framework.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The control identifier, e.g. 164.312(b).",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the control.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Coverage status of the control: covered, partial or gap.",
+							Computed:    true,
+						},
+						"sentry_ids": schema.ListAttribute{
+							Description: "The IDs of the sentries covering the control.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"gaps": schema.ListAttribute{
+							Description: "What is missing for the control to be fully covered.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *ComplianceReportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read generates the compliance report.
+func (d *ComplianceReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComplianceReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := d.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reportRequest := client.ComplianceReportRequest{
+		Framework: data.Framework.ValueString(),
+		Sector:    data.Sector.ValueString(),
+	}
+	if !data.SentryIDs.IsNull() {
+		resp.Diagnostics.Append(data.SentryIDs.ElementsAs(ctx, &reportRequest.SentryIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Generating compliance report", map[string]interface{}{
+		"framework":  reportRequest.Framework,
+		"sector":     reportRequest.Sector,
+		"sentry_ids": reportRequest.SentryIDs,
+	})
+
+	report, err := apiClient.GetComplianceReport(ctx, reportRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Compliance Report", "Could not generate the "+reportRequest.Framework+" compliance report: "+err.Error())
+		return
+	}
+
+	var covered int64
+	controls := make([]ComplianceControlModel, len(report.Controls))
+	for i, control := range report.Controls {
+		if control.Status == client.ControlStatusCovered {
+			covered++
+		}
+
+		sentryIDs, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(control.SentryIDs))
+		resp.Diagnostics.Append(diags...)
+		gaps, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(control.Gaps))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		controls[i] = ComplianceControlModel{
+			ID:        types.StringValue(control.ID),
+			Title:     types.StringValue(control.Title),
+			Status:    types.StringValue(control.Status),
+			SentryIDs: sentryIDs,
+			Gaps:      gaps,
+		}
+	}
+
+	controlList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ComplianceControlModel{}.AttributeTypes()}, controls)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	coverage := 0.0
+	if len(controls) > 0 {
+		coverage = float64(covered) * 100 / float64(len(controls))
+	}
+
+	data.GeneratedAt = types.StringValue(report.GeneratedAt.Format(time.RFC3339))
+	data.ControlsTotal = types.Int64Value(int64(len(controls)))
+	data.ControlsCovered = types.Int64Value(covered)
+	data.CoveragePercent = types.Float64Value(coverage)
+	data.Controls = controlList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// nonNilStrings returns values, or an empty slice when values is nil, so that
+// empty lists are exposed as [] rather than null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComplianceReportDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	reports := map[string]client.ComplianceReport{
+		"empty": {Framework: "empty", GeneratedAt: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)},
+		"hipaa": {Framework: "hipaa", GeneratedAt: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), Controls: []client.ComplianceControl{
+			{ID: "164.308(a)(1)", Title: "Security management", Status: client.ControlStatusCovered, SentryIDs: []string{"apollo-hospital-1700000000"}},
+			{ID: "164.308(a)(6)", Title: "Incident procedures", Status: client.ControlStatusPartial, SentryIDs: []string{"apollo-hospital-1700000000"}, Gaps: []string{"no playbook"}},
+			{ID: "164.312(b)", Title: "Audit controls", Status: client.ControlStatusGap},
+			{ID: "164.312(e)(1)", Title: "Transmission security", Status: client.ControlStatusCovered, SentryIDs: []string{"apollo-clinic-1700000000"}},
+		}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(reports[r.URL.Query().Get("framework")])
+	}))
+	defer server.Close()
+
+	d := &ComplianceReportDataSource{providerData: &ProviderData{Client: client.New(server.URL, "test-key", "test")}}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	testCases := map[string]struct {
+		framework        string
+		expectedTotal    int64
+		expectedCovered  int64
+		expectedCoverage float64
+	}{
+		"no controls":    {framework: "empty"},
+		"mixed controls": {framework: "hipaa", expectedTotal: 4, expectedCovered: 2, expectedCoverage: 50},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for attribute, attributeType := range objectType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+			values["framework"] = tftypes.NewValue(tftypes.String, testCase.framework)
+
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			d.Read(ctx, datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var data ComplianceReportDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.ControlsTotal.ValueInt64() != testCase.expectedTotal || data.ControlsCovered.ValueInt64() != testCase.expectedCovered {
+				t.Errorf("Expected %d of %d controls covered, got %d of %d",
+					testCase.expectedCovered, testCase.expectedTotal, data.ControlsCovered.ValueInt64(), data.ControlsTotal.ValueInt64())
+			}
+			if data.CoveragePercent.ValueFloat64() != testCase.expectedCoverage {
+				t.Errorf("Expected coverage %v%%, got %v%%", testCase.expectedCoverage, data.CoveragePercent.ValueFloat64())
+			}
+			if data.Controls.IsNull() || int64(len(data.Controls.Elements())) != testCase.expectedTotal {
+				t.Fatalf("Expected %d controls, got %s", testCase.expectedTotal, data.Controls)
+			}
+
+			// Controls without sentries or gaps expose empty lists, not null.
+			var controls []ComplianceControlModel
+			resp.Diagnostics.Append(data.Controls.ElementsAs(ctx, &controls, false)...)
+			for _, control := range controls {
+				if control.SentryIDs.IsNull() || control.Gaps.IsNull() {
+					t.Errorf("Expected sentry_ids and gaps of %s to be lists, got %s and %s", control.ID, control.SentryIDs, control.Gaps)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &ConfigSchemaDataSource{}
+	_ datasource.DataSourceWithConfigure      = &ConfigSchemaDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ConfigSchemaDataSource{}
+)
+
+// Sources of the config schema.
+const (
+	configSchemaSourceCatalog = "catalog"
+	configSchemaSourceServer  = "server"
+)
+
+// NewConfigSchemaDataSource is a helper function to simplify the provider implementation.
+func NewConfigSchemaDataSource() datasource.DataSource {
+	return &ConfigSchemaDataSource{}
+}
+
+// ConfigSchemaDataSource describes the config keys a sentry type understands.
+type ConfigSchemaDataSource struct {
+	providerData *ProviderData
+}
+
+// ConfigSchemaDataSourceModel describes the config schema data source data model.
+type ConfigSchemaDataSourceModel struct {
+	SentryType types.String `tfsdk:"sentry_type"`
+	Source     types.String `tfsdk:"source"`
+	Sector     types.String `tfsdk:"sector"`
+	Keys       types.List   `tfsdk:"keys"`
+}
+
+// ConfigKeyModel describes a config key in the list returned by the config schema data source.
+type ConfigKeyModel struct {
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Default       types.String `tfsdk:"default"`
+	AllowedValues types.List   `tfsdk:"allowed_values"`
+	Description   types.String `tfsdk:"description"`
+}
+
+// AttributeTypes returns the attribute types of a config key object.
+func (m ConfigKeyModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":           types.StringType,
+		"type":           types.StringType,
+		"default":        types.StringType,
+		"allowed_values": types.ListType{ElemType: types.StringType},
+		"description":    types.StringType,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *ConfigSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_schema"
+}
+
+// Schema defines the schema for the data source.
+func (d *ConfigSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Describes the keys a sentry type accepts in its config map, with their type, default, allowed values and description.",
+		Attributes: map[string]schema.Attribute{
+			"sentry_type": schema.StringAttribute{
+				Description: "The sentry type, e.g. \"apollo\" or \"sentinel_apollo\".",
+				Required:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Where the schema is read from: catalog, the catalog built into the provider, or server, " +
+					"the Sentinel API, which may know keys added after this provider version. Defaults to catalog.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(configSchemaSourceCatalog, configSchemaSourceServer),
+				},
+			},
+			"sector": schema.StringAttribute{
+				Description: "The sector protected by the sentry type.",
+				Computed:    true,
+			},
+			"keys": schema.ListNestedAttribute{
+				Description: "The accepted config keys, ordered by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The canonical key name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the value: string, number or bool. Values are always given as strings.",
+							Computed:    true,
+						},
+						"default": schema.StringAttribute{
+							Description: "The value applied when the key is omitted. Null when the key has no default.",
+							Computed:    true,
+						},
+						"allowed_values": schema.ListAttribute{
+							Description: "The accepted values. Null when any value of the type is accepted.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "What the key configures.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects unknown sentry types.
+func (d *ConfigSchemaDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var sentryType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sentry_type"), &sentryType)...)
+	if resp.Diagnostics.HasError() || sentryType.IsNull() || sentryType.IsUnknown() {
+		return
+	}
+
+	if _, ok := catalog.LookupResourceType(sentryType.ValueString()); !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("sentry_type"), "Unknown Sentry Type", fmt.Sprintf("%q is not a sentry type.", sentryType.ValueString()))
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *ConfigSchemaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read reads the config schema from the catalog or the Sentinel API.
+func (d *ConfigSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConfigSchemaDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sentry, ok := catalog.LookupResourceType(data.SentryType.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("sentry_type"), "Unknown Sentry Type", fmt.Sprintf("%q is not a sentry type.", data.SentryType.ValueString()))
+		return
+	}
+
+	tflog.Info(ctx, "Reading config schema", map[string]interface{}{
+		"sentry_type": sentry.Type,
+		"source":      data.Source.ValueString(),
+	})
+
+	var keys []client.ConfigKey
+	if data.Source.ValueString() == configSchemaSourceServer {
+		apiClient := d.providerData.apiClient(&resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		keys, err = apiClient.GetConfigSchema(ctx, sentry.Type)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Config Schema", "Could not read the config schema of "+sentry.Type+" sentries: "+err.Error())
+			return
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	} else {
+		for _, key := range sentry.ConfigKeys() {
+			keys = append(keys, client.ConfigKey{
+				Name:          key.Name,
+				Type:          key.Type,
+				Default:       key.Default,
+				AllowedValues: key.AllowedValues,
+				Description:   key.Description,
+			})
+		}
+	}
+
+	models := make([]ConfigKeyModel, len(keys))
+	for i, key := range keys {
+		models[i] = ConfigKeyModel{
+			Name:          types.StringValue(key.Name),
+			Type:          types.StringValue(key.Type),
+			Default:       types.StringNull(),
+			AllowedValues: types.ListNull(types.StringType),
+			Description:   types.StringValue(key.Description),
+		}
+		if key.Default != "" {
+			models[i].Default = types.StringValue(key.Default)
+		}
+		if len(key.AllowedValues) > 0 {
+			allowedValues, diags := types.ListValueFrom(ctx, types.StringType, key.AllowedValues)
+			resp.Diagnostics.Append(diags...)
+			models[i].AllowedValues = allowedValues
+		}
+	}
+
+	keyList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ConfigKeyModel{}.AttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sector = types.StringValue(sentry.Sector)
+	data.Keys = keyList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfigSchemaDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	apollo, _ := catalog.Lookup("apollo")
+	var catalogKeys []string
+	for _, key := range apollo.ConfigKeys() {
+		catalogKeys = append(catalogKeys, key.Name)
+	}
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		if r.URL.Path != "/v1/sentry-types/apollo/config-schema" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string][]client.ConfigKey{"keys": {
+			{Name: "triage_mode", Type: "string", AllowedValues: []string{"manual", "assisted"}},
+			{Name: "threat_level", Type: "string", Default: "medium"},
+		}})
+	}))
+	defer server.Close()
+
+	d := &ConfigSchemaDataSource{providerData: &ProviderData{Client: client.New(server.URL, "test-key", "test")}}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	testCases := map[string]struct {
+		sentryType       string
+		source           string
+		expectError      bool
+		expectedKeys     []string
+		expectedRequests []string
+	}{
+		"catalog by default": {
+			sentryType:   "apollo",
+			expectedKeys: catalogKeys,
+		},
+		"catalog": {
+			sentryType:   "apollo",
+			source:       configSchemaSourceCatalog,
+			expectedKeys: catalogKeys,
+		},
+		"catalog with prefix": {
+			sentryType:   "sentinel_apollo",
+			source:       configSchemaSourceCatalog,
+			expectedKeys: catalogKeys,
+		},
+		"server": {
+			sentryType:       "apollo",
+			source:           configSchemaSourceServer,
+			expectedKeys:     []string{"threat_level", "triage_mode"},
+			expectedRequests: []string{"/v1/sentry-types/apollo/config-schema"},
+		},
+		"server with prefix": {
+			sentryType:       "sentinel_apollo",
+			source:           configSchemaSourceServer,
+			expectedKeys:     []string{"threat_level", "triage_mode"},
+			expectedRequests: []string{"/v1/sentry-types/apollo/config-schema"},
+		},
+		"unknown type": {
+			sentryType:  "zeus",
+			source:      configSchemaSourceServer,
+			expectError: true,
+		},
+		"prefix only": {
+			sentryType:  "sentinel_",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests = nil
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for attribute, attributeType := range objectType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+			values["sentry_type"] = tftypes.NewValue(tftypes.String, testCase.sentryType)
+			if testCase.source != "" {
+				values["source"] = tftypes.NewValue(tftypes.String, testCase.source)
+			}
+
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			d.Read(ctx, datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("Expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+			if !slices.Equal(requests, testCase.expectedRequests) {
+				t.Errorf("Expected requests %v, got %v", testCase.expectedRequests, requests)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			var data ConfigSchemaDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.Sector.ValueString() != apollo.Sector {
+				t.Errorf("Expected sector %s, got %s", apollo.Sector, data.Sector)
+			}
+			if data.SentryType.ValueString() != testCase.sentryType {
+				t.Errorf("Expected sentry_type %s to be kept, got %s", testCase.sentryType, data.SentryType)
+			}
+
+			var keys []ConfigKeyModel
+			resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+			var names []string
+			for _, key := range keys {
+				names = append(names, key.Name.ValueString())
+			}
+			if !slices.Equal(names, testCase.expectedKeys) {
+				t.Errorf("Expected keys %v, got %v", testCase.expectedKeys, names)
+			}
+		})
+	}
+}
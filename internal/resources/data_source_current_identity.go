@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &CurrentIdentityDataSource{}
+	_ datasource.DataSourceWithConfigure = &CurrentIdentityDataSource{}
+)
+
+// NewCurrentIdentityDataSource is a helper function to simplify the provider implementation.
+func NewCurrentIdentityDataSource() datasource.DataSource {
+	return &CurrentIdentityDataSource{}
+}
+
+// CurrentIdentityDataSource reports who the provider is authenticated as.
+type CurrentIdentityDataSource struct {
+	providerData *ProviderData
+}
+
+// CurrentIdentityDataSourceModel describes the current identity data source data model.
+type CurrentIdentityDataSourceModel struct {
+	Endpoint      types.String `tfsdk:"endpoint"`
+	Principal     types.String `tfsdk:"principal"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	Tenant        types.String `tfsdk:"tenant"`
+	Roles         types.List   `tfsdk:"roles"`
+	Scopes        types.List   `tfsdk:"scopes"`
+	KeyExpiresAt  types.String `tfsdk:"key_expires_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *CurrentIdentityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_identity"
+}
+
+// Schema defines the schema for the data source.
+func (d *CurrentIdentityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the principal and tenant the provider is authenticated as, using the endpoint and API key " +
+			"resolved from the provider configuration, so modules can assert they run in the right account.",
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Description: "The Sentinel API endpoint the provider talks to.",
+				Computed:    true,
+			},
+			"principal": schema.StringAttribute{
+				Description: "The authenticated principal, e.g. a user or service account name.",
+				Computed:    true,
+			},
+			"principal_type": schema.StringAttribute{
+				Description: "The kind of principal, e.g. user or service_account.",
+				Computed:    true,
+			},
+			"tenant": schema.StringAttribute{
+				Description: "The tenant the API key belongs to.",
+				Computed:    true,
+			},
+			"roles": schema.ListAttribute{
+				Description: "The roles granted to the principal.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"scopes": schema.ListAttribute{
+				Description: "The scopes granted to the API key.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"key_expires_at": schema.StringAttribute{
+				Description: "Expiry time of the API key (RFC3339 format). Null for keys that do not expire.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *CurrentIdentityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read reads the identity of the configured API key.
+func (d *CurrentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	apiClient := d.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading current identity", map[string]interface{}{
+		"endpoint": apiClient.Endpoint(),
+	})
+
+	identity, err := apiClient.GetCurrentIdentity(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Current Identity", "Could not read the identity of the configured API key: "+err.Error())
+		return
+	}
+
+	roles, diags := types.ListValueFrom(ctx, types.StringType, identity.Roles)
+	resp.Diagnostics.Append(diags...)
+	scopes, diags := types.ListValueFrom(ctx, types.StringType, identity.Scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := CurrentIdentityDataSourceModel{
+		Endpoint:      types.StringValue(apiClient.Endpoint()),
+		Principal:     types.StringValue(identity.Principal),
+		PrincipalType: types.StringValue(identity.PrincipalType),
+		Tenant:        types.StringValue(identity.Tenant),
+		Roles:         roles,
+		Scopes:        scopes,
+		KeyExpiresAt:  types.StringNull(),
+	}
+	if identity.KeyExpiresAt != nil {
+		data.KeyExpiresAt = types.StringValue(identity.KeyExpiresAt.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCurrentIdentityDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	expiresAt := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	identities := map[string]client.Identity{
+		"Bearer ci-key": {
+			Principal: "terraform-ci", PrincipalType: "service_account", Tenant: "acme",
+			Roles: []string{"operator"}, Scopes: []string{"sentries:read", "sentries:write"}, KeyExpiresAt: &expiresAt,
+		},
+		"Bearer admin-key": {
+			Principal: "jdoe", PrincipalType: "user", Tenant: "globex",
+			Roles: []string{"admin", "auditor"}, Scopes: []string{"*"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, ok := identities[r.Header.Get("Authorization")]
+		if r.URL.Path != "/v1/identity" || !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(identity)
+	}))
+	defer server.Close()
+
+	testCases := map[string]struct {
+		apiKey           string
+		tenant           string
+		expectError      bool
+		expectedRoles    []string
+		expectedScopes   []string
+		expectedTenant   string
+		expectedExpiry   string
+		expectedNoExpiry bool
+	}{
+		"expiring service account key": {
+			apiKey:         "ci-key",
+			tenant:         "acme",
+			expectedRoles:  []string{"operator"},
+			expectedScopes: []string{"sentries:read", "sentries:write"},
+			expectedTenant: "acme",
+			expectedExpiry: "2026-12-31T00:00:00Z",
+		},
+		"user key without expiry": {
+			apiKey:           "admin-key",
+			tenant:           "globex",
+			expectedRoles:    []string{"admin", "auditor"},
+			expectedScopes:   []string{"*"},
+			expectedTenant:   "globex",
+			expectedNoExpiry: true,
+		},
+		"unknown key": {apiKey: "revoked-key", expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := &CurrentIdentityDataSource{}
+			configureResp := &datasource.ConfigureResponse{}
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: &ProviderData{
+				Client: client.New(server.URL, testCase.apiKey, "test"),
+				Tenant: testCase.tenant,
+			}}, configureResp)
+			if configureResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", configureResp.Diagnostics)
+			}
+
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for attribute, attributeType := range objectType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			d.Read(ctx, datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("Expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			var data CurrentIdentityDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.Endpoint.ValueString() != server.URL {
+				t.Errorf("Expected endpoint %s, got %s", server.URL, data.Endpoint)
+			}
+			if data.Tenant.ValueString() != testCase.expectedTenant {
+				t.Errorf("Expected tenant %s, got %s", testCase.expectedTenant, data.Tenant)
+			}
+
+			var roles, scopes []string
+			resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+			resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+			if !slices.Equal(roles, testCase.expectedRoles) {
+				t.Errorf("Expected roles %v, got %v", testCase.expectedRoles, roles)
+			}
+			if !slices.Equal(scopes, testCase.expectedScopes) {
+				t.Errorf("Expected scopes %v, got %v", testCase.expectedScopes, scopes)
+			}
+
+			if testCase.expectedNoExpiry {
+				if !data.KeyExpiresAt.IsNull() {
+					t.Errorf("Expected key_expires_at to be null, got %s", data.KeyExpiresAt)
+				}
+			} else if data.KeyExpiresAt.ValueString() != testCase.expectedExpiry {
+				t.Errorf("Expected key_expires_at %s, got %s", testCase.expectedExpiry, data.KeyExpiresAt)
+			}
+		})
+	}
+}
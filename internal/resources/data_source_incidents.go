@@ -0,0 +1,250 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &IncidentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentsDataSource{}
+)
+
+// defaultIncidentWindow is the time window used when window is omitted.
+const defaultIncidentWindow = 24 * time.Hour
+
+// NewIncidentsDataSource is a helper function to simplify the provider implementation.
+func NewIncidentsDataSource() datasource.DataSource {
+	return &IncidentsDataSource{}
+}
+
+// IncidentsDataSource lists recent incidents detected by sentries.
+type IncidentsDataSource struct {
+	providerData *ProviderData
+}
+
+// IncidentsDataSourceModel describes the incidents data source data model.
+type IncidentsDataSourceModel struct {
+	SentryID    types.String `tfsdk:"sentry_id"`
+	Sector      types.String `tfsdk:"sector"`
+	MinSeverity types.String `tfsdk:"min_severity"`
+	Window      types.String `tfsdk:"window"`
+	Incidents   types.List   `tfsdk:"incidents"`
+}
+
+// IncidentModel describes an incident in the list returned by the incidents data source.
+type IncidentModel struct {
+	ID         types.String `tfsdk:"id"`
+	SentryID   types.String `tfsdk:"sentry_id"`
+	Sector     types.String `tfsdk:"sector"`
+	Severity   types.String `tfsdk:"severity"`
+	Category   types.String `tfsdk:"category"`
+	Techniques types.List   `tfsdk:"techniques"`
+	FirstSeen  types.String `tfsdk:"first_seen"`
+	LastSeen   types.String `tfsdk:"last_seen"`
+	State      types.String `tfsdk:"state"`
+}
+
+// AttributeTypes returns the attribute types of an incident object.
+func (m IncidentModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"sentry_id":  types.StringType,
+		"sector":     types.StringType,
+		"severity":   types.StringType,
+		"category":   types.StringType,
+		"techniques": types.ListType{ElemType: types.StringType},
+		"first_seen": types.StringType,
+		"last_seen":  types.StringType,
+		"state":      types.StringType,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *IncidentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incidents"
+}
+
+// Schema defines the schema for the data source.
+func (d *IncidentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the incidents detected by sentries within a recent time window, most recently seen first. " +
+			"All filters are optional and combined.",
+		Attributes: map[string]schema.Attribute{
+			"sentry_id": schema.StringAttribute{
+				Description: "Only list incidents detected by this sentry.",
+				Optional:    true,
+			},
+			"sector": schema.StringAttribute{
+				Description: "Only list incidents of this sector, e.g. Information Technology.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(catalog.Sectors()...),
+				},
+			},
+			"min_severity": schema.StringAttribute{
+				Description: "Only list incidents of at least this severity: low, medium, high or critical.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(SeverityLevels...),
+				},
+			},
+			"window": schema.StringAttribute{
+				Description: "Only list incidents last seen within this duration before now, e.g. 30m or 72h. Defaults to 24h.",
+				Optional:    true,
+				Validators: []validator.String{
+					durationValidator{min: time.Second},
+				},
+			},
+			"incidents": schema.ListNestedAttribute{
+				Description: "The matching incidents.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the incident.",
+							Computed:    true,
+						},
+						"sentry_id": schema.StringAttribute{
+							Description: "The ID of the sentry that detected the incident.",
+							Computed:    true,
+						},
+						"sector": schema.StringAttribute{
+							Description: "The sector of the sentry that detected the incident.",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "The severity of the incident: low, medium, high or critical.",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "The category of the incident, e.g. intrusion or malware.",
+							Computed:    true,
+						},
+						"techniques": schema.ListAttribute{
+							Description: "The MITRE ATT&CK technique IDs observed, e.g. T1059.001.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"first_seen": schema.StringAttribute{
+							Description: "Time the incident was first seen (RFC3339 format).",
+							Computed:    true,
+						},
+						"last_seen": schema.StringAttribute{
+							Description: "Time the incident was last seen (RFC3339 format).",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the incident, e.g. open, acknowledged or resolved.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *IncidentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read lists the matching incidents, following all pages.
+func (d *IncidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := d.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := parseIncidentWindow(data.Window)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("window"), "Invalid Incident Window", err.Error())
+		return
+	}
+
+	filter := client.IncidentFilter{
+		SentryID:    data.SentryID.ValueString(),
+		Sector:      data.Sector.ValueString(),
+		MinSeverity: data.MinSeverity.ValueString(),
+		Since:       time.Now().Add(-window),
+	}
+
+	tflog.Info(ctx, "Listing incidents", map[string]interface{}{
+		"sentry_id":    filter.SentryID,
+		"sector":       filter.Sector,
+		"min_severity": filter.MinSeverity,
+		"since":        filter.Since.Format(time.RFC3339),
+	})
+
+	incidents := []IncidentModel{}
+	for incident, err := range apiClient.ListIncidents(ctx, filter) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error Listing Incidents", "Could not list incidents: "+err.Error())
+			return
+		}
+
+		techniques, diags := types.ListValueFrom(ctx, types.StringType, incident.Techniques)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		incidents = append(incidents, IncidentModel{
+			ID:         types.StringValue(incident.ID),
+			SentryID:   types.StringValue(incident.SentryID),
+			Sector:     types.StringValue(incident.Sector),
+			Severity:   types.StringValue(incident.Severity),
+			Category:   types.StringValue(incident.Category),
+			Techniques: techniques,
+			FirstSeen:  types.StringValue(incident.FirstSeen.Format(time.RFC3339)),
+			LastSeen:   types.StringValue(incident.LastSeen.Format(time.RFC3339)),
+			State:      types.StringValue(incident.State),
+		})
+	}
+
+	incidentList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: IncidentModel{}.AttributeTypes()}, incidents)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Incidents = incidentList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseIncidentWindow parses the window attribute, falling back to the default
+// window when it is null. The value was already checked by durationValidator.
+func parseIncidentWindow(window types.String) (time.Duration, error) {
+	if window.IsNull() {
+		return defaultIncidentWindow, nil
+	}
+
+	duration, err := time.ParseDuration(window.ValueString())
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("window must be a positive duration, got %s", window.ValueString())
+	}
+	return duration, nil
+}
@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseIncidentWindow(t *testing.T) {
+	testCases := map[string]struct {
+		window      types.String
+		expected    time.Duration
+		expectError bool
+	}{
+		"null":     {window: types.StringNull(), expected: defaultIncidentWindow},
+		"minutes":  {window: types.StringValue("30m"), expected: 30 * time.Minute},
+		"hours":    {window: types.StringValue("72h"), expected: 72 * time.Hour},
+		"zero":     {window: types.StringValue("0s"), expectError: true},
+		"negative": {window: types.StringValue("-1h"), expectError: true},
+		"invalid":  {window: types.StringValue("one day"), expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			window, err := parseIncidentWindow(testCase.window)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error, got %s", window)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if window != testCase.expected {
+				t.Errorf("Expected window %s, got %s", testCase.expected, window)
+			}
+		})
+	}
+}
+
+func TestIncidentWindowValidation(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	(&IncidentsDataSource{}).Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	window := schemaResp.Schema.Attributes["window"].(schema.StringAttribute)
+
+	for value, valid := range map[string]bool{
+		"30m":     true,
+		"720h":    true,
+		"0s":      false,
+		"-1h":     false,
+		"one day": false,
+	} {
+		resp := &validator.StringResponse{}
+		for _, v := range window.Validators {
+			v.ValidateString(ctx, validator.StringRequest{
+				Path:        path.Root("window"),
+				ConfigValue: types.StringValue(value),
+			}, resp)
+		}
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("Expected %q valid=%t, got diagnostics %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ModelVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ModelVersionsDataSource{}
+)
+
+// NewModelVersionsDataSource is a helper function to simplify the provider implementation.
+func NewModelVersionsDataSource() datasource.DataSource {
+	return &ModelVersionsDataSource{}
+}
+
+// ModelVersionsDataSource lists the published versions of the AI detection models.
+type ModelVersionsDataSource struct {
+	providerData *ProviderData
+}
+
+// ModelVersionsDataSourceModel describes the model versions data source data model.
+type ModelVersionsDataSourceModel struct {
+	Sector            types.String `tfsdk:"sector"`
+	IncludeDeprecated types.Bool   `tfsdk:"include_deprecated"`
+	Latest            types.String `tfsdk:"latest"`
+	Versions          types.List   `tfsdk:"versions"`
+}
+
+// ModelVersionModel describes a model version in the list returned by the model versions data source.
+type ModelVersionModel struct {
+	Version          types.String `tfsdk:"version"`
+	Sector           types.String `tfsdk:"sector"`
+	ReleasedAt       types.String `tfsdk:"released_at"`
+	ChangelogSummary types.String `tfsdk:"changelog_summary"`
+	Metrics          types.Map    `tfsdk:"metrics"`
+	DeprecatedAt     types.String `tfsdk:"deprecated_at"`
+	Deprecated       types.Bool   `tfsdk:"deprecated"`
+}
+
+// AttributeTypes returns the attribute types of a model version object.
+func (m ModelVersionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"version":           types.StringType,
+		"sector":            types.StringType,
+		"released_at":       types.StringType,
+		"changelog_summary": types.StringType,
+		"metrics":           types.MapType{ElemType: types.Float64Type},
+		"deprecated_at":     types.StringType,
+		"deprecated":        types.BoolType,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *ModelVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_versions"
+}
+
+// Schema defines the schema for the data source.
+func (d *ModelVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the published versions of the AI detection models that sentries run, newest first, " +
+			"so model versions can be pinned or upgraded deliberately.",
+		Attributes: map[string]schema.Attribute{
+			"sector": schema.StringAttribute{
+				Description: "Only list the model versions of this sector, e.g. Healthcare.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(catalog.Sectors()...),
+				},
+			},
+			"include_deprecated": schema.BoolAttribute{
+				Description: "Whether versions that are already deprecated are listed. Defaults to false.",
+				Optional:    true,
+			},
+			"latest": schema.StringAttribute{
+				Description: "The newest listed version of the sector that is not deprecated. " +
+					"Null when there is none or sector is not set.",
+				Computed: true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "The model versions, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: "The model version, e.g. 2025.3.1.",
+							Computed:    true,
+						},
+						"sector": schema.StringAttribute{
+							Description: "The sector the model is trained for.",
+							Computed:    true,
+						},
+						"released_at": schema.StringAttribute{
+							Description: "Release time of the version (RFC3339 format).",
+							Computed:    true,
+						},
+						"changelog_summary": schema.StringAttribute{
+							Description: "Summary of the changes in the version.",
+							Computed:    true,
+						},
+						"metrics": schema.MapAttribute{
+							Description: "Evaluation metrics of the version, e.g. precision, recall and f1.",
+							ElementType: types.Float64Type,
+							Computed:    true,
+						},
+						"deprecated_at": schema.StringAttribute{
+							Description: "Time the version is or was deprecated (RFC3339 format). Null when no deprecation is scheduled.",
+							Computed:    true,
+						},
+						"deprecated": schema.BoolAttribute{
+							Description: "Whether the version is deprecated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *ModelVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read lists the published model versions.
+func (d *ModelVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := d.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Listing model versions", map[string]interface{}{
+		"sector": data.Sector.ValueString(),
+	})
+
+	modelVersions, err := apiClient.ListModelVersions(ctx, data.Sector.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Model Versions", "Could not list model versions: "+err.Error())
+		return
+	}
+
+	versions, latest, diags := modelVersionModels(ctx, modelVersions, !data.Sector.IsNull(), data.IncludeDeprecated.ValueBool(), time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Latest = latest
+
+	versionList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ModelVersionModel{}.AttributeTypes()}, versions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Versions = versionList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// modelVersionModels converts the model versions returned by the Sentinel API
+// ordered by release time, newest first, skipping versions deprecated at now
+// unless includeDeprecated is set. It also returns the newest version that is
+// not deprecated, which is null unless the versions are of a single sector.
+func modelVersionModels(ctx context.Context, modelVersions []client.ModelVersion, singleSector, includeDeprecated bool, now time.Time) ([]ModelVersionModel, types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The API does not guarantee an order.
+	modelVersions = slices.Clone(modelVersions)
+	slices.SortStableFunc(modelVersions, func(a, b client.ModelVersion) int {
+		return b.ReleasedAt.Compare(a.ReleasedAt)
+	})
+
+	latest := types.StringNull()
+	versions := []ModelVersionModel{}
+	for _, modelVersion := range modelVersions {
+		deprecated := modelVersion.DeprecatedAt != nil && !modelVersion.DeprecatedAt.After(now)
+		if deprecated && !includeDeprecated {
+			continue
+		}
+
+		metrics, metricsDiags := types.MapValueFrom(ctx, types.Float64Type, modelVersion.Metrics)
+		diags.Append(metricsDiags...)
+		if diags.HasError() {
+			return nil, latest, diags
+		}
+
+		version := ModelVersionModel{
+			Version:          types.StringValue(modelVersion.Version),
+			Sector:           types.StringValue(modelVersion.Sector),
+			ReleasedAt:       types.StringValue(modelVersion.ReleasedAt.Format(time.RFC3339)),
+			ChangelogSummary: types.StringValue(modelVersion.ChangelogSummary),
+			Metrics:          metrics,
+			DeprecatedAt:     types.StringNull(),
+			Deprecated:       types.BoolValue(deprecated),
+		}
+		if modelVersion.DeprecatedAt != nil {
+			version.DeprecatedAt = types.StringValue(modelVersion.DeprecatedAt.Format(time.RFC3339))
+		}
+		// Versions of different sectors are not comparable.
+		if singleSector && !deprecated && latest.IsNull() {
+			latest = version.Version
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, latest, diags
+}
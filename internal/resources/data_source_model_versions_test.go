@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+)
+
+func TestModelVersionModels(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	past, future := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+
+	modelVersions := []client.ModelVersion{
+		{Version: "3.0.0", Sector: "Healthcare", DeprecatedAt: &past},
+		{Version: "2.1.0", Sector: "Healthcare", DeprecatedAt: &future},
+		{Version: "2.0.0", Sector: "Healthcare", DeprecatedAt: &now},
+		{Version: "1.0.0", Sector: "Healthcare"},
+	}
+
+	testCases := map[string]struct {
+		singleSector      bool
+		includeDeprecated bool
+		expectedVersions  []string
+		expectedLatest    string
+	}{
+		"sector": {
+			singleSector:     true,
+			expectedVersions: []string{"2.1.0", "1.0.0"},
+			expectedLatest:   "2.1.0",
+		},
+		"sector including deprecated": {
+			singleSector:      true,
+			includeDeprecated: true,
+			expectedVersions:  []string{"3.0.0", "2.1.0", "2.0.0", "1.0.0"},
+			expectedLatest:    "2.1.0",
+		},
+		"all sectors": {
+			expectedVersions: []string{"2.1.0", "1.0.0"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			versions, latest, diags := modelVersionModels(context.Background(), modelVersions, testCase.singleSector, testCase.includeDeprecated, now)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var listed []string
+			for _, version := range versions {
+				listed = append(listed, version.Version.ValueString())
+				deprecated := version.Version.ValueString() == "3.0.0" || version.Version.ValueString() == "2.0.0"
+				if version.Deprecated.ValueBool() != deprecated {
+					t.Errorf("Expected version %s deprecated %t", version.Version.ValueString(), deprecated)
+				}
+			}
+			if !slices.Equal(listed, testCase.expectedVersions) {
+				t.Errorf("Expected versions %v, got %v", testCase.expectedVersions, listed)
+			}
+
+			if testCase.expectedLatest == "" {
+				if !latest.IsNull() {
+					t.Errorf("Expected latest to be null, got %s", latest)
+				}
+			} else if latest.ValueString() != testCase.expectedLatest {
+				t.Errorf("Expected latest %s, got %s", testCase.expectedLatest, latest)
+			}
+		})
+	}
+}
+
+func TestModelVersionModelsOutOfOrder(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	released := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+	past := released(1)
+
+	// The newest version is deprecated, so latest is the next newest one even
+	// though the API lists an older version first.
+	modelVersions := []client.ModelVersion{
+		{Version: "2.0.0", Sector: "Healthcare", ReleasedAt: released(90)},
+		{Version: "2.2.0", Sector: "Healthcare", ReleasedAt: released(10)},
+		{Version: "3.0.0", Sector: "Healthcare", ReleasedAt: released(5), DeprecatedAt: &past},
+		{Version: "2.1.0", Sector: "Healthcare", ReleasedAt: released(30)},
+	}
+
+	versions, latest, diags := modelVersionModels(context.Background(), modelVersions, true, true, now)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var listed []string
+	for _, version := range versions {
+		listed = append(listed, version.Version.ValueString())
+	}
+	if expected := []string{"3.0.0", "2.2.0", "2.1.0", "2.0.0"}; !slices.Equal(listed, expected) {
+		t.Errorf("Expected versions %v, got %v", expected, listed)
+	}
+	if latest.ValueString() != "2.2.0" {
+		t.Errorf("Expected latest 2.2.0, got %s", latest)
+	}
+	if modelVersions[0].Version != "2.0.0" {
+		t.Error("Expected the API response not to be reordered in place")
+	}
+}
@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &SectorsDataSource{}
+)
+
+// NewSectorsDataSource is a helper function to simplify the provider implementation.
+func NewSectorsDataSource() datasource.DataSource {
+	return &SectorsDataSource{}
+}
+
+// SectorsDataSource exposes the built-in catalog of sectors and the sentries protecting them.
+type SectorsDataSource struct{}
+
+// SectorsDataSourceModel describes the sectors data source data model.
+type SectorsDataSourceModel struct {
+	Critical types.Bool `tfsdk:"critical"`
+	Sectors  types.List `tfsdk:"sectors"`
+}
+
+// SectorModel describes a sector in the list returned by the sectors data source.
+type SectorModel struct {
+	Name         types.String `tfsdk:"name"`
+	Slug         types.String `tfsdk:"slug"`
+	Description  types.String `tfsdk:"description"`
+	Critical     types.Bool   `tfsdk:"critical"`
+	SentryType   types.String `tfsdk:"sentry_type"`
+	SentryName   types.String `tfsdk:"sentry_name"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ConfigKeys   types.List   `tfsdk:"config_keys"`
+}
+
+// AttributeTypes returns the attribute types of a sector object.
+func (m SectorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":          types.StringType,
+		"slug":          types.StringType,
+		"description":   types.StringType,
+		"critical":      types.BoolType,
+		"sentry_type":   types.StringType,
+		"sentry_name":   types.StringType,
+		"resource_type": types.StringType,
+		"config_keys":   types.ListType{ElemType: types.StringType},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *SectorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sectors"
+}
+
+// Schema defines the schema for the data source.
+func (d *SectorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the critical infrastructure sectors and the sentry resource type protecting each of them, " +
+			"so modules can iterate over sectors instead of hard-coding them. The catalog is built into the provider.",
+		Attributes: map[string]schema.Attribute{
+			"critical": schema.BoolAttribute{
+				Description: "Only list critical (true) or non-critical (false) sectors.",
+				Optional:    true,
+			},
+			"sectors": schema.ListNestedAttribute{
+				Description: "The sectors, ordered by sentry type.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The canonical name of the sector, e.g. Food & Agriculture.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "The sector name in lower case with hyphens, e.g. food-agriculture. Suitable as a for_each key.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "What the sector covers.",
+							Computed:    true,
+						},
+						"critical": schema.BoolAttribute{
+							Description: "Whether the sector is critical. Sentries of critical sectors are protected.",
+							Computed:    true,
+						},
+						"sentry_type": schema.StringAttribute{
+							Description: "The sentry type protecting the sector, e.g. demeter.",
+							Computed:    true,
+						},
+						"sentry_name": schema.StringAttribute{
+							Description: "The display name of the sentry, e.g. Demeter.",
+							Computed:    true,
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "The Terraform resource type of the sentry, e.g. sentinel_demeter.",
+							Computed:    true,
+						},
+						"config_keys": schema.ListAttribute{
+							Description: "The config keys the sentry supports, ordered by name.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read lists the sectors of the catalog.
+func (d *SectorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SectorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sectors := []SectorModel{}
+	for _, sentry := range catalog.All() {
+		if !data.Critical.IsNull() && sentry.Critical != data.Critical.ValueBool() {
+			continue
+		}
+
+		sector, diags := newSectorModel(ctx, sentry)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		sectors = append(sectors, sector)
+	}
+
+	sectorList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: SectorModel{}.AttributeTypes()}, sectors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sectors = sectorList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newSectorModel converts a catalog entry.
+func newSectorModel(ctx context.Context, sentry catalog.Sentry) (SectorModel, diag.Diagnostics) {
+	keys := sentry.ConfigKeys()
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.Name
+	}
+
+	configKeys, diags := types.ListValueFrom(ctx, types.StringType, names)
+
+	return SectorModel{
+		Name:         types.StringValue(sentry.Sector),
+		Slug:         types.StringValue(sentry.Slug()),
+		Description:  types.StringValue(sentry.Description),
+		Critical:     types.BoolValue(sentry.Critical),
+		SentryType:   types.StringValue(sentry.Type),
+		SentryName:   types.StringValue(sentry.Name),
+		ResourceType: types.StringValue(sentry.ResourceType()),
+		ConfigKeys:   configKeys,
+	}, diags
+}
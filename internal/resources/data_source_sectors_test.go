@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSectorsDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	d := &SectorsDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	testCases := map[string]struct {
+		critical any
+		expected func(catalog.Sentry) bool
+	}{
+		"all sectors":  {expected: func(catalog.Sentry) bool { return true }},
+		"critical":     {critical: true, expected: func(sentry catalog.Sentry) bool { return sentry.Critical }},
+		"non-critical": {critical: false, expected: func(sentry catalog.Sentry) bool { return !sentry.Critical }},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for attribute, attributeType := range objectType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+			values["critical"] = tftypes.NewValue(tftypes.Bool, testCase.critical)
+
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			d.Read(ctx, datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var data SectorsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			var sectors []SectorModel
+			resp.Diagnostics.Append(data.Sectors.ElementsAs(ctx, &sectors, false)...)
+
+			var expected []catalog.Sentry
+			for _, sentry := range catalog.All() {
+				if testCase.expected(sentry) {
+					expected = append(expected, sentry)
+				}
+			}
+			if len(expected) == 0 || len(sectors) != len(expected) {
+				t.Fatalf("Expected %d sectors, got %d", len(expected), len(sectors))
+			}
+
+			for i, sentry := range expected {
+				sector := sectors[i]
+				if sector.Name.ValueString() != sentry.Sector || sector.Slug.ValueString() != sentry.Slug() ||
+					sector.Description.ValueString() != sentry.Description || sector.Critical.ValueBool() != sentry.Critical {
+					t.Errorf("Expected sector %q (%s), got %+v", sentry.Sector, sentry.Slug(), sector)
+				}
+				if sector.SentryType.ValueString() != sentry.Type || sector.SentryName.ValueString() != sentry.Name ||
+					sector.ResourceType.ValueString() != sentry.ResourceType() {
+					t.Errorf("Expected sentry %s (%s) for %q, got %s (%s)",
+						sentry.Type, sentry.ResourceType(), sentry.Sector, sector.SentryType, sector.ResourceType)
+				}
+
+				var configKeys, expectedKeys []string
+				resp.Diagnostics.Append(sector.ConfigKeys.ElementsAs(ctx, &configKeys, false)...)
+				for _, key := range sentry.ConfigKeys() {
+					expectedKeys = append(expectedKeys, key.Name)
+				}
+				if !slices.Equal(configKeys, expectedKeys) {
+					t.Errorf("Expected config keys %v for %q, got %v", expectedKeys, sentry.Sector, configKeys)
+				}
+			}
+		})
+	}
+}
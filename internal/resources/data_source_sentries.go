@@ -0,0 +1,254 @@
+package resources
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &SentriesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &SentriesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &SentriesDataSource{}
+)
+
+// NewSentriesDataSource is a helper function to simplify the provider implementation.
+func NewSentriesDataSource() datasource.DataSource {
+	return &SentriesDataSource{}
+}
+
+// SentriesDataSource lists the existing sentries matching a set of filters.
+type SentriesDataSource struct {
+	providerData *ProviderData
+}
+
+// SentriesDataSourceModel describes the sentries data source data model.
+type SentriesDataSourceModel struct {
+	Sector    types.String `tfsdk:"sector"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Status    types.String `tfsdk:"status"`
+	Tags      types.Map    `tfsdk:"tags"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	IDs       types.List   `tfsdk:"ids"`
+	Sentries  types.List   `tfsdk:"sentries"`
+}
+
+// SentrySummaryModel describes a sentry in the list returned by the sentries data source.
+type SentrySummaryModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Sector     types.String `tfsdk:"sector"`
+	SentryType types.String `tfsdk:"sentry_type"`
+	Status     types.String `tfsdk:"status"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+// AttributeTypes returns the attribute types of a sentry summary object.
+func (m SentrySummaryModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"sector":      types.StringType,
+		"sentry_type": types.StringType,
+		"status":      types.StringType,
+		"enabled":     types.BoolType,
+		"tags":        types.MapType{ElemType: types.StringType},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *SentriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sentries"
+}
+
+// Schema defines the schema for the data source.
+func (d *SentriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the existing sentries of all types matching the given filters. All filters are optional and combined.",
+		Attributes: map[string]schema.Attribute{
+			"sector": schema.StringAttribute{
+				Description: "Only list sentries of this sector, e.g. Healthcare.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(catalog.Sectors()...),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only list enabled (true) or disabled (false) sentries.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only list sentries with this status, e.g. active.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only list sentries carrying all of these tag key/value pairs.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only list sentries whose name matches this regular expression (RE2 syntax).",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "The IDs of the matching sentries.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"sentries": schema.ListNestedAttribute{
+				Description: "The matching sentries.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the sentry.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the sentry.",
+							Computed:    true,
+						},
+						"sector": schema.StringAttribute{
+							Description: "The critical infrastructure sector of the sentry.",
+							Computed:    true,
+						},
+						"sentry_type": schema.StringAttribute{
+							Description: "The sentry type, e.g. apollo.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The current operational status of the sentry.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the sentry is enabled.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags of the sentry.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects name_regex values that are not valid regular expressions.
+func (d *SentriesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *SentriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read lists the matching sentries, following all pages.
+func (d *SentriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SentriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := d.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := client.SentryFilter{
+		Sector: data.Sector.ValueString(),
+		Status: data.Status.ValueString(),
+	}
+	if !data.Enabled.IsNull() {
+		enabled := data.Enabled.ValueBool()
+		filter.Enabled = &enabled
+	}
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &filter.Tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The API has no regular expression filter, so names are matched here.
+	var nameRegexp *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegexp, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing sentries", map[string]interface{}{
+		"sector":     filter.Sector,
+		"status":     filter.Status,
+		"name_regex": data.NameRegex.ValueString(),
+	})
+
+	ids := []string{}
+	summaries := []SentrySummaryModel{}
+	for sentry, err := range apiClient.ListSentries(ctx, filter) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error Listing Sentries", "Could not list sentries: "+err.Error())
+			return
+		}
+		if nameRegexp != nil && !nameRegexp.MatchString(sentry.Name) {
+			continue
+		}
+
+		tags, diags := types.MapValueFrom(ctx, types.StringType, sentry.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ids = append(ids, sentry.ID)
+		summaries = append(summaries, SentrySummaryModel{
+			ID:         types.StringValue(sentry.ID),
+			Name:       types.StringValue(sentry.Name),
+			Sector:     types.StringValue(sentry.Sector),
+			SentryType: types.StringValue(sentry.Type),
+			Status:     types.StringValue(sentry.Status),
+			Enabled:    types.BoolValue(sentry.Enabled),
+			Tags:       tags,
+		})
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	sentryList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: SentrySummaryModel{}.AttributeTypes()}, summaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.IDs = idList
+	data.Sentries = sentryList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
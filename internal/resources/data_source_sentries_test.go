@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSentriesDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("sector") == "Nuclear" {
+			_ = json.NewEncoder(w).Encode(client.SentryPage{})
+			return
+		}
+
+		page := client.SentryPage{Sentries: []client.Sentry{
+			{ID: "ra-grid-east-1700000000", Type: "ra", Name: "grid-east", Sector: "Energy"},
+			{ID: "ra-grid-west-1700000000", Type: "ra", Name: "grid-west", Sector: "Energy"},
+		}, NextPageToken: "2"}
+		if query.Get("page_token") == "2" {
+			page = client.SentryPage{Sentries: []client.Sentry{
+				{ID: "ra-plant-1700000000", Type: "ra", Name: "plant", Sector: "Energy"},
+			}}
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	d := &SentriesDataSource{providerData: &ProviderData{Client: client.New(server.URL, "test-key", "test")}}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	testCases := map[string]struct {
+		sector      string
+		nameRegex   string
+		expectedIDs []string
+	}{
+		"all pages": {
+			sector:      "Energy",
+			expectedIDs: []string{"ra-grid-east-1700000000", "ra-grid-west-1700000000", "ra-plant-1700000000"},
+		},
+		"name regex": {
+			sector:      "Energy",
+			nameRegex:   "^grid-",
+			expectedIDs: []string{"ra-grid-east-1700000000", "ra-grid-west-1700000000"},
+		},
+		"name regex without match": {
+			sector:      "Energy",
+			nameRegex:   "^dam-",
+			expectedIDs: []string{},
+		},
+		"no sentries": {
+			sector:      "Nuclear",
+			expectedIDs: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for attribute, attributeType := range objectType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+			values["sector"] = tftypes.NewValue(tftypes.String, testCase.sector)
+			if testCase.nameRegex != "" {
+				values["name_regex"] = tftypes.NewValue(tftypes.String, testCase.nameRegex)
+			}
+
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			d.Read(ctx, datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var data SentriesDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.IDs.IsNull() || data.Sentries.IsNull() {
+				t.Fatal("Expected ids and sentries to be empty lists, not null")
+			}
+
+			var ids []string
+			resp.Diagnostics.Append(data.IDs.ElementsAs(ctx, &ids, false)...)
+			if !slices.Equal(ids, testCase.expectedIDs) {
+				t.Errorf("Expected ids %v, got %v", testCase.expectedIDs, ids)
+			}
+			if len(data.Sentries.Elements()) != len(testCase.expectedIDs) {
+				t.Errorf("Expected %d sentries, got %d", len(testCase.expectedIDs), len(data.Sentries.Elements()))
+			}
+		})
+	}
+}
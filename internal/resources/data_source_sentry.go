@@ -0,0 +1,340 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &SentryDataSource{}
+	_ datasource.DataSourceWithConfigure        = &SentryDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &SentryDataSource{}
+)
+
+// NewSentryDataSource is a helper function to simplify the provider implementation.
+func NewSentryDataSource() datasource.DataSource {
+	return &SentryDataSource{}
+}
+
+// SentryDataSource looks up a single existing sentry of any type.
+type SentryDataSource struct {
+	providerData *ProviderData
+}
+
+// SentryDataSourceModel describes the sentry data source data model.
+type SentryDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Sector                 types.String `tfsdk:"sector"`
+	Name                   types.String `tfsdk:"name"`
+	SentryType             types.String `tfsdk:"sentry_type"`
+	Description            types.String `tfsdk:"description"`
+	Status                 types.String `tfsdk:"status"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	DeletionProtection     types.Bool   `tfsdk:"deletion_protection"`
+	Monitoring             types.Object `tfsdk:"monitoring"`
+	Alerting               types.Object `tfsdk:"alerting"`
+	NotificationChannelIDs types.List   `tfsdk:"notification_channel_ids"`
+	ThreatLevel            types.String `tfsdk:"threat_level"`
+	Config                 types.Map    `tfsdk:"config"`
+	Tags                   types.Map    `tfsdk:"tags"`
+	LastUpdated            types.String `tfsdk:"last_updated"`
+	Health                 types.Object `tfsdk:"health"`
+}
+
+// Metadata returns the data source type name.
+func (d *SentryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sentry"
+}
+
+// Schema defines the schema for the data source.
+func (d *SentryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single existing sentry of any type, either by its ID or by its sector and name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the sentry. Conflicts with sector and name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sector": schema.StringAttribute{
+				Description: "The critical infrastructure sector of the sentry, e.g. Healthcare. Must be set together with name.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(catalog.Sectors()...),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the sentry. Must be set together with sector.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sentry_type": schema.StringAttribute{
+				Description: "The sentry type, e.g. apollo.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the sentry.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The current operational status of the sentry.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the sentry is enabled.",
+				Computed:    true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether the sentry is protected against deletion.",
+				Computed:    true,
+			},
+			"monitoring": schema.SingleNestedAttribute{
+				Description: "Monitoring settings of the sentry.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"interval_seconds": schema.Int64Attribute{
+						Description: "Interval between monitoring passes, in seconds.",
+						Computed:    true,
+					},
+					"mode": schema.StringAttribute{
+						Description: "Monitoring mode: continuous, scheduled or on_demand.",
+						Computed:    true,
+					},
+				},
+			},
+			"alerting": schema.SingleNestedAttribute{
+				Description: "Alerting settings of the sentry.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"threshold": schema.StringAttribute{
+						Description: "Minimum severity that triggers an alert.",
+						Computed:    true,
+					},
+					"emails": schema.ListAttribute{
+						Description: "Email addresses that receive alerts.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			"notification_channel_ids": schema.ListAttribute{
+				Description: "The IDs of the notification channels alerts are delivered to.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"threat_level": schema.StringAttribute{
+				Description: "The baseline threat level of the sentry.",
+				Computed:    true,
+			},
+			"config": schema.MapAttribute{
+				Description: "Sentry-specific configuration.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags of the sentry.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update to the sentry (RFC3339 format).",
+				Computed:    true,
+			},
+			"health": schema.SingleNestedAttribute{
+				Description: "Live operational state of the sentry.",
+				Computed:    true,
+				Attributes:  sentryHealthAttributes(),
+			},
+		},
+	}
+}
+
+// sentryHealthAttributes returns the data source attributes describing the health of a sentry.
+func sentryHealthAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"last_heartbeat": schema.StringAttribute{
+			Description: "Time of the last heartbeat received from the sentry (RFC3339 format).",
+			Computed:    true,
+		},
+		"sensors_total": schema.Int64Attribute{
+			Description: "Number of sensors attached to the sentry.",
+			Computed:    true,
+		},
+		"sensors_online": schema.Int64Attribute{
+			Description: "Number of sensors currently reporting.",
+			Computed:    true,
+		},
+		"events_per_minute": schema.Float64Attribute{
+			Description: "Events processed per minute.",
+			Computed:    true,
+		},
+		"model_version": schema.StringAttribute{
+			Description: "Version of the detection model the sentry runs.",
+			Computed:    true,
+		},
+		"last_error": schema.StringAttribute{
+			Description: "The last error reported by the sentry, if any.",
+			Computed:    true,
+		},
+		"health_score": schema.Float64Attribute{
+			Description: "Overall health score, from 0 (down) to 100 (fully healthy).",
+			Computed:    true,
+		},
+	}
+}
+
+// ConfigValidators requires either id or both sector and name.
+func (d *SentryDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		datasourcevalidator.Conflicting(path.MatchRoot("id"), path.MatchRoot("sector")),
+		datasourcevalidator.RequiredTogether(path.MatchRoot("sector"), path.MatchRoot("name")),
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *SentryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read looks up the sentry and its health.
+func (d *SentryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SentryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := d.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading sentry", map[string]interface{}{
+		"id":     data.ID.ValueString(),
+		"sector": data.Sector.ValueString(),
+		"name":   data.Name.ValueString(),
+	})
+
+	var sentry *client.Sentry
+	if !data.ID.IsNull() {
+		sentry = getSentry(ctx, apiClient, data.ID.ValueString(), &resp.Diagnostics)
+	} else {
+		sentry = findSentry(ctx, apiClient, data.Sector.ValueString(), data.Name.ValueString(), &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	health, err := apiClient.GetSentryHealth(ctx, sentry.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Sentry Health", "Could not read the health of sentry "+sentry.ID+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.setSentry(ctx, *sentry, *health)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setSentry populates the model from the sentry and health returned by the Sentinel API.
+func (m *SentryDataSourceModel) setSentry(ctx context.Context, sentry client.Sentry, health client.SentryHealth) diag.Diagnostics {
+	var model SentryResourceModel
+	diags := model.SetAPISentry(ctx, sentry)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ID = model.ID
+	m.Sector = model.Sector
+	m.Name = model.Name
+	m.SentryType = types.StringValue(sentry.Type)
+	m.Description = model.Description
+	m.Status = model.Status
+	m.Enabled = model.Enabled
+	m.DeletionProtection = model.DeletionProtection
+	m.Monitoring = model.Monitoring
+	m.Alerting = model.Alerting
+	m.NotificationChannelIDs = model.NotificationChannelIDs
+	m.ThreatLevel = model.ThreatLevel
+	m.Config = model.Config
+	m.Tags = model.Tags
+	m.LastUpdated = model.LastUpdated
+
+	healthModel := NewSentryHealthModel(health)
+	var d diag.Diagnostics
+	m.Health, d = types.ObjectValueFrom(ctx, healthModel.AttributeTypes(), healthModel)
+	diags.Append(d...)
+
+	return diags
+}
+
+// getSentry reads the sentry with the given ID, adding an error when it does not exist.
+func getSentry(ctx context.Context, apiClient *client.Client, id string, diags *diag.Diagnostics) *client.Sentry {
+	sentry, err := apiClient.GetSentry(ctx, id)
+	if client.IsNotFound(err) {
+		diags.AddAttributeError(path.Root("id"), "Sentry Not Found", fmt.Sprintf("No sentry with ID %q exists.", id))
+		return nil
+	}
+	if err != nil {
+		diags.AddError("Error Reading Sentry", "Could not read sentry "+id+": "+err.Error())
+		return nil
+	}
+	return sentry
+}
+
+// findSentry looks up the sentry with the given name in the sector, adding an
+// error unless exactly one sentry matches.
+func findSentry(ctx context.Context, apiClient *client.Client, sector, name string, diags *diag.Diagnostics) *client.Sentry {
+	var matches []client.Sentry
+	for sentry, err := range apiClient.ListSentries(ctx, client.SentryFilter{Sector: sector, NamePrefix: name}) {
+		if err != nil {
+			diags.AddError("Error Listing Sentries", "Could not list the sentries of sector "+sector+": "+err.Error())
+			return nil
+		}
+		if sentry.Name == name {
+			matches = append(matches, sentry)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Sentry Not Found",
+			fmt.Sprintf("No sentry named %q exists in sector %q.", name, sector),
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	}
+
+	ids := make([]string, len(matches))
+	for i, match := range matches {
+		ids[i] = match.ID
+	}
+	diags.AddAttributeError(
+		path.Root("name"),
+		"Multiple Sentries Found",
+		fmt.Sprintf("%d sentries named %q exist in sector %q: %s. Look the sentry up by id instead.", len(matches), name, sector, strings.Join(ids, ", ")),
+	)
+	return nil
+}
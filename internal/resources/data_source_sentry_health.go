@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SentryHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &SentryHealthDataSource{}
+)
+
+// NewSentryHealthDataSource is a helper function to simplify the provider implementation.
+func NewSentryHealthDataSource() datasource.DataSource {
+	return &SentryHealthDataSource{}
+}
+
+// SentryHealthDataSource reads the live operational metrics of a sentry.
+type SentryHealthDataSource struct {
+	providerData *ProviderData
+}
+
+// SentryHealthDataSourceModel describes the sentry health data source data model.
+type SentryHealthDataSourceModel struct {
+	SentryID        types.String  `tfsdk:"sentry_id"`
+	LastHeartbeat   types.String  `tfsdk:"last_heartbeat"`
+	SensorsTotal    types.Int64   `tfsdk:"sensors_total"`
+	SensorsOnline   types.Int64   `tfsdk:"sensors_online"`
+	EventsPerMinute types.Float64 `tfsdk:"events_per_minute"`
+	ModelVersion    types.String  `tfsdk:"model_version"`
+	LastError       types.String  `tfsdk:"last_error"`
+	HealthScore     types.Float64 `tfsdk:"health_score"`
+}
+
+// Metadata returns the data source type name.
+func (d *SentryHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sentry_health"
+}
+
+// Schema defines the schema for the data source.
+func (d *SentryHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := sentryHealthAttributes()
+	attributes["sentry_id"] = schema.StringAttribute{
+		Description: "The ID of the sentry.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads the live operational metrics of a sentry, for example to gate downstream deployments " +
+			"with precondition and postcondition checks. The values are read on every plan.",
+		Attributes: attributes,
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *SentryHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read reads the current health of the sentry.
+func (d *SentryHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SentryHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := d.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.SentryID.ValueString()
+
+	tflog.Info(ctx, "Reading sentry health", map[string]interface{}{
+		"sentry_id": id,
+	})
+
+	health, err := apiClient.GetSentryHealth(ctx, id)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(path.Root("sentry_id"), "Sentry Not Found", "No sentry with ID "+id+" exists.")
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Sentry Health", "Could not read the health of sentry "+id+": "+err.Error())
+		return
+	}
+
+	model := NewSentryHealthModel(*health)
+	data.LastHeartbeat = model.LastHeartbeat
+	data.SensorsTotal = model.SensorsTotal
+	data.SensorsOnline = model.SensorsOnline
+	data.EventsPerMinute = model.EventsPerMinute
+	data.ModelVersion = model.ModelVersion
+	data.LastError = model.LastError
+	data.HealthScore = model.HealthScore
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
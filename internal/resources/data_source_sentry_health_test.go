@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSentryHealthDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	healths := map[string]client.SentryHealth{
+		"/v1/sentries/apollo-hospital-1700000000/health": {
+			LastHeartbeat:   time.Date(2026, 6, 1, 12, 30, 0, 0, time.UTC),
+			SensorsTotal:    12,
+			SensorsOnline:   9,
+			EventsPerMinute: 42.5,
+			ModelVersion:    "2.1.0",
+			LastError:       "sensor 7 unreachable",
+			HealthScore:     75,
+		},
+		"/v1/sentries/apollo-clinic-1700000000/health": {
+			ModelVersion: "2.1.0",
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		health, ok := healths[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(health)
+	}))
+	defer server.Close()
+
+	d := &SentryHealthDataSource{providerData: &ProviderData{Client: client.New(server.URL, "test-key", "test")}}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	testCases := map[string]struct {
+		sentryID    string
+		expectError bool
+		expected    SentryHealthDataSourceModel
+	}{
+		"healthy": {
+			sentryID: "apollo-hospital-1700000000",
+			expected: SentryHealthDataSourceModel{
+				LastHeartbeat: types.StringValue("2026-06-01T12:30:00Z"),
+				SensorsTotal:  types.Int64Value(12),
+				SensorsOnline: types.Int64Value(9),
+				LastError:     types.StringValue("sensor 7 unreachable"),
+				HealthScore:   types.Float64Value(75),
+			},
+		},
+		"never reported": {
+			sentryID: "apollo-clinic-1700000000",
+			expected: SentryHealthDataSourceModel{
+				LastHeartbeat: types.StringNull(),
+				SensorsTotal:  types.Int64Value(0),
+				SensorsOnline: types.Int64Value(0),
+				LastError:     types.StringNull(),
+				HealthScore:   types.Float64Value(0),
+			},
+		},
+		"not found": {sentryID: "apollo-lab-1700000000", expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for attribute, attributeType := range objectType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+			values["sentry_id"] = tftypes.NewValue(tftypes.String, testCase.sentryID)
+
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			d.Read(ctx, datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("Expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			var data SentryHealthDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if !data.HealthScore.Equal(testCase.expected.HealthScore) {
+				t.Errorf("Expected health score %s, got %s", testCase.expected.HealthScore, data.HealthScore)
+			}
+			if !data.SensorsTotal.Equal(testCase.expected.SensorsTotal) || !data.SensorsOnline.Equal(testCase.expected.SensorsOnline) {
+				t.Errorf("Expected %s of %s sensors online, got %s of %s",
+					testCase.expected.SensorsOnline, testCase.expected.SensorsTotal, data.SensorsOnline, data.SensorsTotal)
+			}
+			if !data.LastHeartbeat.Equal(testCase.expected.LastHeartbeat) {
+				t.Errorf("Expected last heartbeat %s, got %s", testCase.expected.LastHeartbeat, data.LastHeartbeat)
+			}
+			if !data.LastError.Equal(testCase.expected.LastError) {
+				t.Errorf("Expected last error %s, got %s", testCase.expected.LastError, data.LastError)
+			}
+			if data.SentryID.ValueString() != testCase.sentryID {
+				t.Errorf("Expected sentry ID %s, got %s", testCase.sentryID, data.SentryID)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestFindSentry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("sector") != "Healthcare" {
+			t.Errorf("Unexpected filter %s", r.URL.RawQuery)
+		}
+		_ = json.NewEncoder(w).Encode(client.SentryPage{Sentries: []client.Sentry{
+			{ID: "apollo-hospital-1700000000", Name: "hospital"},
+			{ID: "apollo-hospital-east-1700000000", Name: "hospital-east"},
+			{ID: "apollo-clinic-1700000000", Name: "clinic"},
+			{ID: "apollo-clinic-1700000001", Name: "clinic"},
+		}})
+	}))
+	defer server.Close()
+
+	apiClient := client.New(server.URL, "test-key", "test")
+
+	testCases := map[string]struct {
+		name       string
+		expectedID string
+	}{
+		"single match":     {name: "hospital", expectedID: "apollo-hospital-1700000000"},
+		"no match":         {name: "pharmacy"},
+		"multiple matches": {name: "clinic"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			sentry := findSentry(context.Background(), apiClient, "Healthcare", testCase.name, &diags)
+
+			if testCase.expectedID == "" {
+				if !diags.HasError() {
+					t.Fatalf("Expected an error, got sentry %+v", sentry)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if sentry.ID != testCase.expectedID {
+				t.Errorf("Expected sentry %s, got %s", testCase.expectedID, sentry.ID)
+			}
+		})
+	}
+}
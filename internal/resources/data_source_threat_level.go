@@ -0,0 +1,202 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ThreatLevelDataSource{}
+	_ datasource.DataSourceWithConfigure = &ThreatLevelDataSource{}
+)
+
+// NewThreatLevelDataSource is a helper function to simplify the provider implementation.
+func NewThreatLevelDataSource() datasource.DataSource {
+	return &ThreatLevelDataSource{}
+}
+
+// ThreatLevelDataSource reads the current threat level of one or all sectors.
+type ThreatLevelDataSource struct {
+	providerData *ProviderData
+}
+
+// ThreatLevelDataSourceModel describes the threat level data source data model.
+type ThreatLevelDataSourceModel struct {
+	Sector    types.String `tfsdk:"sector"`
+	Level     types.String `tfsdk:"level"`
+	Advisory  types.String `tfsdk:"advisory"`
+	ChangedAt types.String `tfsdk:"changed_at"`
+	Sectors   types.List   `tfsdk:"sectors"`
+}
+
+// SectorThreatLevelModel describes the threat level of a single sector.
+type SectorThreatLevelModel struct {
+	Sector    types.String `tfsdk:"sector"`
+	Level     types.String `tfsdk:"level"`
+	Advisory  types.String `tfsdk:"advisory"`
+	ChangedAt types.String `tfsdk:"changed_at"`
+}
+
+// AttributeTypes returns the attribute types of a sector threat level object.
+func (m SectorThreatLevelModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sector":     types.StringType,
+		"level":      types.StringType,
+		"advisory":   types.StringType,
+		"changed_at": types.StringType,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *ThreatLevelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_threat_level"
+}
+
+// Schema defines the schema for the data source.
+func (d *ThreatLevelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the current threat level of a sector, or of all sectors, for use in check blocks " +
+			"and preconditions. The values are read on every plan.",
+		Attributes: map[string]schema.Attribute{
+			"sector": schema.StringAttribute{
+				Description: "The sector to read, e.g. Energy. When omitted, all sectors are read and level is the highest of them.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(catalog.Sectors()...),
+				},
+			},
+			"level": schema.StringAttribute{
+				Description: "The current threat level of the sector, or the highest threat level of all sectors: low, medium, high or critical.",
+				Computed:    true,
+			},
+			"advisory": schema.StringAttribute{
+				Description: "The advisory published with the threat level returned in level. Null when there is none.",
+				Computed:    true,
+			},
+			"changed_at": schema.StringAttribute{
+				Description: "Time the threat level returned in level last changed (RFC3339 format).",
+				Computed:    true,
+			},
+			"sectors": schema.ListNestedAttribute{
+				Description: "The threat level of every sector read.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sector": schema.StringAttribute{
+							Description: "The sector.",
+							Computed:    true,
+						},
+						"level": schema.StringAttribute{
+							Description: "The current threat level: low, medium, high or critical.",
+							Computed:    true,
+						},
+						"advisory": schema.StringAttribute{
+							Description: "The advisory published with the threat level. Null when there is none.",
+							Computed:    true,
+						},
+						"changed_at": schema.StringAttribute{
+							Description: "Time the threat level last changed (RFC3339 format).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configuration to the data source.
+func (d *ThreatLevelDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.providerData = configureDataSourceProviderData(req, resp)
+}
+
+// Read reads the current threat levels.
+func (d *ThreatLevelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ThreatLevelDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := d.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sector := data.Sector.ValueString()
+
+	tflog.Info(ctx, "Reading threat levels", map[string]interface{}{
+		"sector": sector,
+	})
+
+	threatLevels, err := apiClient.ListThreatLevels(ctx, sector)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Threat Levels", "Could not read the current threat levels: "+err.Error())
+		return
+	}
+	if len(threatLevels) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("sector"), "Threat Level Not Found", "The Sentinel API returned no threat level for sector "+sector+".")
+		return
+	}
+
+	sectors := make([]SectorThreatLevelModel, len(threatLevels))
+	for i, threatLevel := range threatLevels {
+		sectors[i] = SectorThreatLevelModel{
+			Sector:    types.StringValue(threatLevel.Sector),
+			Level:     types.StringValue(threatLevel.Level),
+			Advisory:  types.StringNull(),
+			ChangedAt: types.StringValue(threatLevel.ChangedAt.Format(time.RFC3339)),
+		}
+		if threatLevel.Advisory != "" {
+			sectors[i].Advisory = types.StringValue(threatLevel.Advisory)
+		}
+	}
+
+	sectorList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: SectorThreatLevelModel{}.AttributeTypes()}, sectors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	highest := highestThreatLevel(threatLevels)
+	data.Level = sectors[highest].Level
+	data.Advisory = sectors[highest].Advisory
+	data.ChangedAt = sectors[highest].ChangedAt
+	data.Sectors = sectorList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// highestThreatLevel returns the index of the highest threat level, the first
+// one on ties. Unknown levels rank below all known levels.
+func highestThreatLevel(threatLevels []client.ThreatLevel) int {
+	highest := 0
+	for i, threatLevel := range threatLevels {
+		if severityRank(threatLevel.Level) > severityRank(threatLevels[highest].Level) {
+			highest = i
+		}
+	}
+	return highest
+}
+
+// severityRank returns the position of level in SeverityLevels, or -1 for unknown levels.
+func severityRank(level string) int {
+	for i, severity := range SeverityLevels {
+		if severity == level {
+			return i
+		}
+	}
+	return -1
+}
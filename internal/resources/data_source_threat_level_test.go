@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+)
+
+func TestSeverityRank(t *testing.T) {
+	for level, expected := range map[string]int{
+		"low":      0,
+		"medium":   1,
+		"high":     2,
+		"critical": 3,
+		"severe":   -1,
+		"":         -1,
+	} {
+		if rank := severityRank(level); rank != expected {
+			t.Errorf("Expected rank %d for %q, got %d", expected, level, rank)
+		}
+	}
+}
+
+func TestHighestThreatLevel(t *testing.T) {
+	testCases := map[string]struct {
+		levels   []string
+		expected int
+	}{
+		"single sector":      {levels: []string{"medium"}, expected: 0},
+		"highest last":       {levels: []string{"low", "medium", "critical"}, expected: 2},
+		"highest first":      {levels: []string{"high", "low", "medium"}, expected: 0},
+		"first on ties":      {levels: []string{"medium", "high", "high"}, expected: 1},
+		"unknown level":      {levels: []string{"severe", "low"}, expected: 1},
+		"only unknown level": {levels: []string{"severe", "elevated"}, expected: 0},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			threatLevels := make([]client.ThreatLevel, len(testCase.levels))
+			for i, level := range testCase.levels {
+				threatLevels[i] = client.ThreatLevel{Level: level}
+			}
+
+			if highest := highestThreatLevel(threatLevels); highest != testCase.expected {
+				t.Errorf("Expected index %d, got %d", testCase.expected, highest)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = durationValidator{}
+
+// durationValidator checks that a string is a Go duration, e.g. 90s or 5m,
+// between min and max. A zero max means there is no upper bound.
+type durationValidator struct {
+	min time.Duration
+	max time.Duration
+}
+
+// Description describes the validation in plain text formatting.
+func (v durationValidator) Description(_ context.Context) string {
+	if v.max == 0 {
+		return fmt.Sprintf("must be a duration such as 90s or 5m of at least %s", v.min)
+	}
+	return fmt.Sprintf("must be a duration such as 90s or 5m between %s and %s", v.min, v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration < v.min || (v.max != 0 && duration > v.max) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Value %q %s.", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
+
+// durationValue returns the duration value read from the Sentinel API, keeping
+// current when it spells the same duration. The API normalizes durations, e.g.
+// 60m to 1h0m0s, which would otherwise show as drift against the configuration.
+// An empty value is null.
+func durationValue(current types.String, value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	if !current.IsNull() && !current.IsUnknown() {
+		currentDuration, currentErr := time.ParseDuration(current.ValueString())
+		duration, err := time.ParseDuration(value)
+		if currentErr == nil && err == nil && currentDuration == duration {
+			return current
+		}
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,285 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &SentryTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &SentryTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &SentryTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &SentryTokenEphemeralResource{}
+)
+
+const (
+	// defaultSentryTokenTTLSeconds is the token lifetime used when ttl_seconds is omitted.
+	defaultSentryTokenTTLSeconds = 900
+	// sentryTokenRenewalMargin is how long before expiry Terraform is asked to renew a token.
+	sentryTokenRenewalMargin = time.Minute
+	// sentryTokenPrivateKey is the private data key holding the token reference.
+	sentryTokenPrivateKey = "token"
+)
+
+// SentryTokenScopes lists the scopes a sentry access token may be granted.
+var SentryTokenScopes = []string{"read", "ingest", "respond"}
+
+// NewSentryTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewSentryTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &SentryTokenEphemeralResource{}
+}
+
+// SentryTokenEphemeralResource mints short-lived access tokens scoped to a single sentry.
+// Tokens are never written to the plan or state.
+type SentryTokenEphemeralResource struct {
+	providerData *ProviderData
+}
+
+// SentryTokenModel describes the sentry token data model.
+type SentryTokenModel struct {
+	SentryID   types.String `tfsdk:"sentry_id"`
+	TTLSeconds types.Int64  `tfsdk:"ttl_seconds"`
+	Scopes     types.List   `tfsdk:"scopes"`
+	ID         types.String `tfsdk:"id"`
+	Token      types.String `tfsdk:"token"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// sentryTokenPrivateData references an open token so it can be renewed and revoked.
+type sentryTokenPrivateData struct {
+	SentryID string `json:"sentry_id"`
+	TokenID  string `json:"token_id"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *SentryTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sentry_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *SentryTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Mints a short-lived access token scoped to a single sentry, for sensor agents and dashboards. " +
+			"The token is renewed while Terraform uses it, revoked afterwards, and never stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"sentry_id": schema.StringAttribute{
+				Description: "The ID of the sentry the token grants access to.",
+				Required:    true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				Description: "Lifetime of the token in seconds, between 300 and 86400. Defaults to 900.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(300, 86400),
+				},
+			},
+			"scopes": schema.ListAttribute{
+				Description: "Scopes granted to the token: read, ingest or respond. Defaults to read.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(SentryTokenScopes...)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The identifier of the token.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The access token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiry time of the token (RFC3339 format).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configuration to the ephemeral resource.
+func (r *SentryTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.providerData = configureEphemeralProviderData(req, resp)
+}
+
+// Open mints the token.
+func (r *SentryTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SentryTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenRequest := client.SentryTokenRequest{
+		TTLSeconds: defaultSentryTokenTTLSeconds,
+		Scopes:     []string{"read"},
+	}
+	if !data.TTLSeconds.IsNull() {
+		tokenRequest.TTLSeconds = data.TTLSeconds.ValueInt64()
+	}
+	if !data.Scopes.IsNull() {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &tokenRequest.Scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Opening sentry token", map[string]interface{}{
+		"sentry_id": data.SentryID.ValueString(),
+		"scopes":    tokenRequest.Scopes,
+	})
+
+	token, err := apiClient.CreateSentryToken(ctx, data.SentryID.ValueString(), tokenRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Sentry Token", "Could not create a token for sentry "+data.SentryID.ValueString()+": "+err.Error())
+		return
+	}
+
+	// Revoke the token if it cannot be handed to Terraform, Close is never
+	// called for it and it would otherwise stay valid until it expires.
+	defer func() {
+		if resp.Diagnostics.HasError() {
+			r.revokeUnusedToken(ctx, apiClient, data.SentryID.ValueString(), token.ID, &resp.Diagnostics)
+		}
+	}()
+
+	scopes, diags := types.ListValueFrom(ctx, types.StringType, token.Scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TTLSeconds = types.Int64Value(tokenRequest.TTLSeconds)
+	data.Scopes = scopes
+	data.ID = types.StringValue(token.ID)
+	data.Token = types.StringValue(token.Token)
+	data.ExpiresAt = types.StringValue(token.ExpiresAt.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	private, err := json.Marshal(sentryTokenPrivateData{SentryID: data.SentryID.ValueString(), TokenID: token.ID})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Storing Sentry Token Reference", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, sentryTokenPrivateKey, private)...)
+
+	resp.RenewAt = token.ExpiresAt.Add(-sentryTokenRenewalMargin)
+}
+
+// Renew extends the lifetime of the token while Terraform is still using it.
+func (r *SentryTokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	reference, ok := readSentryTokenPrivateData(ctx, req.Private, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Renewing sentry token", map[string]interface{}{
+		"sentry_id": reference.SentryID,
+		"token_id":  reference.TokenID,
+	})
+
+	token, err := apiClient.RenewSentryToken(ctx, reference.SentryID, reference.TokenID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Renewing Sentry Token", "Could not renew token "+reference.TokenID+": "+err.Error())
+		return
+	}
+
+	resp.RenewAt = token.ExpiresAt.Add(-sentryTokenRenewalMargin)
+}
+
+// Close revokes the token once Terraform no longer needs it.
+func (r *SentryTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	reference, ok := readSentryTokenPrivateData(ctx, req.Private, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Closing sentry token", map[string]interface{}{
+		"sentry_id": reference.SentryID,
+		"token_id":  reference.TokenID,
+	})
+
+	err := apiClient.RevokeSentryToken(ctx, reference.SentryID, reference.TokenID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Revoking Sentry Token", "Could not revoke token "+reference.TokenID+": "+err.Error())
+	}
+}
+
+// revokeUnusedToken revokes a token that Open failed to return. A failure is
+// reported as a warning next to the error that caused it.
+func (r *SentryTokenEphemeralResource) revokeUnusedToken(ctx context.Context, apiClient *client.Client, sentryID, tokenID string, diags *diag.Diagnostics) {
+	tflog.Info(ctx, "Revoking unused sentry token", map[string]interface{}{
+		"sentry_id": sentryID,
+		"token_id":  tokenID,
+	})
+
+	err := apiClient.RevokeSentryToken(ctx, sentryID, tokenID)
+	if err != nil && !client.IsNotFound(err) {
+		diags.AddWarning(
+			"Error Revoking Unused Sentry Token",
+			"Could not revoke token "+tokenID+" after opening it failed, it stays valid until it expires: "+err.Error(),
+		)
+	}
+}
+
+// sentryTokenPrivateReader is implemented by the private data of renew and close requests.
+type sentryTokenPrivateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// readSentryTokenPrivateData reads the token reference stored by Open.
+func readSentryTokenPrivateData(ctx context.Context, private sentryTokenPrivateReader, diags *diag.Diagnostics) (sentryTokenPrivateData, bool) {
+	var reference sentryTokenPrivateData
+
+	data, getDiags := private.GetKey(ctx, sentryTokenPrivateKey)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return reference, false
+	}
+	if len(data) == 0 {
+		diags.AddError("Missing Sentry Token Reference", "The token reference stored when the token was opened is missing. Please report this issue to the provider developers.")
+		return reference, false
+	}
+
+	if err := json.Unmarshal(data, &reference); err != nil {
+		diags.AddError("Invalid Sentry Token Reference", err.Error())
+		return reference, false
+	}
+
+	return reference, true
+}
@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSentryTokenOpenRevokesUnusedToken(t *testing.T) {
+	ctx := context.Background()
+
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPost {
+			_ = json.NewEncoder(w).Encode(client.SentryToken{
+				ID: "tok-1", Token: "s3cr3t", Scopes: []string{"read"}, ExpiresAt: time.Now().Add(time.Hour),
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	r := &SentryTokenEphemeralResource{providerData: &ProviderData{Client: client.New(server.URL, "test-key", "test")}}
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["sentry_id"] = tftypes.NewValue(tftypes.String, "apollo-hospital-1700000000")
+
+	// Without private data storing the token reference fails after the token
+	// was created, so Close could never revoke it.
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error")
+	}
+	expected := []string{
+		"POST /v1/sentries/apollo-hospital-1700000000/tokens",
+		"DELETE /v1/sentries/apollo-hospital-1700000000/tokens/tok-1",
+	}
+	if !slices.Equal(methods, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, methods)
+	}
+}
@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// isProtected reports whether the sentry is protected, either because it belongs
+// to a critical sector or because its tags match the provider's protected_tags.
+func (d *ProviderData) isProtected(ctx context.Context, sentry catalog.Sentry, tags types.Map) (bool, error) {
+	if sentry.Critical {
+		return true, nil
+	}
+
+	if len(d.ProtectedTags) == 0 || tags.IsNull() || tags.IsUnknown() {
+		return false, nil
+	}
+
+	var values map[string]string
+	if diags := tags.ElementsAs(ctx, &values, false); diags.HasError() {
+		return false, fmt.Errorf("reading tags: %v", diags)
+	}
+
+	for key, value := range d.ProtectedTags {
+		if values[key] == value {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkDeletionProtection returns an error when the sentry's own deletion_protection is enabled.
+func checkDeletionProtection(state SentryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.DeletionProtection.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("deletion_protection"),
+			"Sentry Is Protected From Deletion",
+			fmt.Sprintf("Sentry %q (%s) has deletion_protection enabled. "+
+				"Set deletion_protection = false and apply before destroying it.",
+				state.Name.ValueString(), state.ID.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// modifySentryPlan warns when a sentry is being disabled, more strongly when it
+// is protected, and rejects plans that destroy a sentry covered by its own or
+// the provider's deletion protection.
+func modifySentryPlan(ctx context.Context, sentryType string, providerData *ProviderData, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to guard when the resource is being created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	sentry, ok := catalog.Lookup(sentryType)
+	if !ok {
+		resp.Diagnostics.AddError("Unknown Sentry Type", fmt.Sprintf("Sentry type %q is not in the sentry catalog.", sentryType))
+		return
+	}
+
+	if providerData == nil {
+		providerData = defaultProviderData
+	}
+
+	var state SentryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(checkDeletionProtection(state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	protected, err := providerData.isProtected(ctx, sentry, state.Tags)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("tags"), "Invalid Sentry Tags", err.Error())
+		return
+	}
+
+	typeName := sentry.ResourceType()
+
+	if req.Plan.Raw.IsNull() {
+		if !protected {
+			return
+		}
+		if !providerData.DeletionProtection {
+			tflog.Warn(ctx, "Destroying protected sentry with deletion protection disabled", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Protected Sentry Cannot Be Destroyed",
+			fmt.Sprintf("%s %q (%s) protects the %s sector and is covered by deletion protection. "+
+				"To destroy it, set deletion_protection = false in the provider configuration and plan again.",
+				typeName, state.Name.ValueString(), state.ID.ValueString(), sentry.Sector),
+		)
+		return
+	}
+
+	var plan SentryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Enabled.ValueBool() || plan.Enabled.IsUnknown() || plan.Enabled.ValueBool() {
+		return
+	}
+
+	if protected {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("enabled"),
+			"Protected Sentry Will Be Disabled",
+			fmt.Sprintf("Applying this plan stops %s %q from monitoring the %s sector. "+
+				"The sector remains unprotected until the sentry is enabled again.",
+				typeName, state.Name.ValueString(), sentry.Sector),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("enabled"),
+		"Sentry Will Be Disabled",
+		fmt.Sprintf("Applying this plan stops %s %q from monitoring the %s sector until the sentry is enabled again.",
+			typeName, state.Name.ValueString(), sentry.Sector),
+	)
+}
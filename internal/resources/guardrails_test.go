@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderDataIsProtected(t *testing.T) {
+	ctx := context.Background()
+
+	providerData := &ProviderData{
+		DeletionProtection: true,
+		ProtectedTags:      map[string]string{"environment": "production"},
+	}
+
+	shiva, _ := catalog.Lookup("shiva")
+	apollo, _ := catalog.Lookup("apollo")
+
+	production := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"environment": types.StringValue("production"),
+	})
+	staging := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"environment": types.StringValue("staging"),
+	})
+
+	testCases := map[string]struct {
+		sentry   catalog.Sentry
+		tags     types.Map
+		expected bool
+	}{
+		"critical sector":      {sentry: shiva, tags: types.MapNull(types.StringType), expected: true},
+		"matching tag":         {sentry: apollo, tags: production, expected: true},
+		"non-matching tag":     {sentry: apollo, tags: staging, expected: false},
+		"no tags":              {sentry: apollo, tags: types.MapNull(types.StringType), expected: false},
+		"unknown tags":         {sentry: apollo, tags: types.MapUnknown(types.StringType), expected: false},
+		"critical sector tags": {sentry: shiva, tags: staging, expected: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			protected, err := providerData.isProtected(ctx, testCase.sentry, testCase.tags)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if protected != testCase.expected {
+				t.Errorf("Expected protected to be %t, got %t", testCase.expected, protected)
+			}
+		})
+	}
+}
+
+func TestCheckDeletionProtection(t *testing.T) {
+	state := SentryResourceModel{
+		ID:                 types.StringValue("sobek-dam-1700000000"),
+		Name:               types.StringValue("dam"),
+		DeletionProtection: types.BoolValue(true),
+	}
+	if diags := checkDeletionProtection(state); !diags.HasError() {
+		t.Error("Expected an error while deletion_protection is true")
+	}
+
+	state.DeletionProtection = types.BoolValue(false)
+	if diags := checkDeletionProtection(state); diags.HasError() {
+		t.Errorf("Expected no error while deletion_protection is false, got %v", diags)
+	}
+}
+
+func TestDeletionProtectionDefault(t *testing.T) {
+	testCases := map[string]bool{
+		"Dams":       true,
+		"Energy":     true,
+		"Water":      true,
+		"Healthcare": false,
+	}
+
+	for sector, expected := range testCases {
+		t.Run(sector, func(t *testing.T) {
+			attribute, ok := GetCommonSentrySchema(sector, "").Attributes["deletion_protection"].(schema.BoolAttribute)
+			if !ok {
+				t.Fatal("Expected deletion_protection to be a bool attribute")
+			}
+
+			resp := &defaults.BoolResponse{}
+			attribute.Default.DefaultBool(context.Background(), defaults.BoolRequest{}, resp)
+			if resp.PlanValue.ValueBool() != expected {
+				t.Errorf("Expected deletion_protection to default to %t, got %t", expected, resp.PlanValue.ValueBool())
+			}
+		})
+	}
+}
+
+func TestModifySentryPlanDisableWarning(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := GetCommonSentrySchema("Healthcare", "test")
+	providerData := &ProviderData{DeletionProtection: true}
+
+	testCases := map[string]struct {
+		sentryType      string
+		planEnabled     bool
+		expectedWarning string
+	}{
+		"unprotected sentry disabled": {sentryType: "apollo", expectedWarning: "Sentry Will Be Disabled"},
+		"protected sentry disabled":   {sentryType: "shiva", expectedWarning: "Protected Sentry Will Be Disabled"},
+		"sentry kept enabled":         {sentryType: "apollo", planEnabled: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sentry := func(enabled bool) tftypes.Value {
+				return sentryValue(ctx, map[string]tftypes.Value{
+					"id":      tftypes.NewValue(tftypes.String, testCase.sentryType+"-main-1700000000"),
+					"name":    tftypes.NewValue(tftypes.String, "main"),
+					"enabled": tftypes.NewValue(tftypes.Bool, enabled),
+				})
+			}
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: resourceSchema, Raw: sentry(true)},
+				Plan:  tfsdk.Plan{Schema: resourceSchema, Raw: sentry(testCase.planEnabled)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			modifySentryPlan(ctx, testCase.sentryType, providerData, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if testCase.expectedWarning == "" {
+				if len(warnings) > 0 {
+					t.Errorf("Expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != testCase.expectedWarning {
+				t.Errorf("Expected warning %q, got %v", testCase.expectedWarning, warnings)
+			}
+		})
+	}
+}
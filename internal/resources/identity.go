@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SentryIdentityModel describes the resource identity that is common to all sentries.
+type SentryIdentityModel struct {
+	Tenant     types.String `tfsdk:"tenant"`
+	SentryType types.String `tfsdk:"sentry_type"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// GetSentryIdentitySchema returns the identity schema shared by all sentry resources.
+func GetSentryIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant": identityschema.StringAttribute{
+				Description:       "The tenant owning the sentry. Defaults to the tenant of the provider configuration.",
+				OptionalForImport: true,
+			},
+			"sentry_type": identityschema.StringAttribute{
+				Description:       "The sentry type, e.g. apollo. Defaults to the type of the imported resource.",
+				OptionalForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the sentry.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// newSentryIdentity returns the identity of the sentry with the given ID. The
+// tenant is taken from a tenant-qualified ID, falling back to the provider's
+// tenant, and the identity ID is always unqualified.
+func newSentryIdentity(providerData *ProviderData, sentryType, id string) SentryIdentityModel {
+	tenant, unqualified := splitSentryTenant(id)
+	identity := SentryIdentityModel{
+		Tenant:     types.StringNull(),
+		SentryType: types.StringValue(sentryType),
+		ID:         types.StringValue(unqualified),
+	}
+
+	if tenant != "" {
+		identity.Tenant = types.StringValue(tenant)
+	} else if providerData != nil && providerData.Tenant != "" {
+		identity.Tenant = types.StringValue(providerData.Tenant)
+	}
+
+	return identity
+}
+
+// setSentryIdentity stores the identity of the sentry with the given ID. It is
+// a no-op for Terraform versions without resource identity support.
+func setSentryIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, providerData *ProviderData, sentryType, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, newSentryIdentity(providerData, sentryType, id))
+}
+
+// importSentryState imports a sentry either by its import ID or by its
+// resource identity (Terraform 1.12+), rejecting sentries of another type or tenant.
+func importSentryState(ctx context.Context, sentryType string, providerData *ProviderData, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+
+	if id == "" {
+		var identity SentryIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !identity.SentryType.IsNull() && identity.SentryType.ValueString() != sentryType {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sentry_type"),
+				"Sentry Type Mismatch",
+				fmt.Sprintf("Cannot import a %s sentry into a sentinel_%s resource.", identity.SentryType.ValueString(), sentryType),
+			)
+			return
+		}
+
+		if !identity.Tenant.IsNull() && providerData != nil && providerData.Tenant != "" && identity.Tenant.ValueString() != providerData.Tenant {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tenant"),
+				"Sentry Tenant Mismatch",
+				fmt.Sprintf("Cannot import a sentry of tenant %q with a provider configured for tenant %q.", identity.Tenant.ValueString(), providerData.Tenant),
+			)
+			return
+		}
+
+		id = identity.ID.ValueString()
+	}
+
+	// The Sentinel API only knows unqualified IDs, the tenant is kept in the
+	// identity.
+	tenant, unqualified := splitSentryTenant(id)
+	if unqualified == "" || strings.HasPrefix(id, "/") || strings.Contains(unqualified, "/") {
+		resp.Diagnostics.AddError("Invalid Sentry ID", fmt.Sprintf("Sentry ID %q is not of the form [<tenant>/]<id>.", id))
+		return
+	}
+	if tenant != "" && providerData != nil && providerData.Tenant != "" && tenant != providerData.Tenant {
+		resp.Diagnostics.AddError(
+			"Sentry Tenant Mismatch",
+			fmt.Sprintf("Sentry ID %q belongs to tenant %q and cannot be imported with a provider configured for tenant %q.", id, tenant, providerData.Tenant),
+		)
+		return
+	}
+
+	// IDs are assigned by the Sentinel API and need not have the
+	// <type>-<name>-<created> form. Their type can then only be checked when
+	// the sentry is read after the import.
+	if parsed, err := ParseSentryID(unqualified); err == nil && parsed.Type != sentryType {
+		resp.Diagnostics.AddError(
+			"Sentry Type Mismatch",
+			fmt.Sprintf("Sentry ID %q belongs to a %s sentry and cannot be imported into a sentinel_%s resource.", id, parsed.Type, sentryType),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), unqualified)...)
+	resp.Diagnostics.Append(setSentryIdentity(ctx, resp.Identity, providerData, sentryType, id)...)
+}
@@ -0,0 +1,168 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNewSentryIdentity(t *testing.T) {
+	providerData := &ProviderData{Tenant: "acme"}
+
+	testCases := map[string]struct {
+		providerData *ProviderData
+		id           string
+		tenant       types.String
+	}{
+		"provider tenant":  {providerData: providerData, id: "apollo-hospital-1700000000", tenant: types.StringValue("acme")},
+		"qualified id":     {providerData: providerData, id: "globex/apollo-hospital-1700000000", tenant: types.StringValue("globex")},
+		"no tenant":        {providerData: defaultProviderData, id: "apollo-hospital-1700000000", tenant: types.StringNull()},
+		"unconfigured":     {providerData: nil, id: "apollo-hospital-1700000000", tenant: types.StringNull()},
+		"qualified only":   {providerData: defaultProviderData, id: "globex/apollo-hospital-1700000000", tenant: types.StringValue("globex")},
+		"server assigned":  {providerData: providerData, id: "snt_01HZX3", tenant: types.StringValue("acme")},
+		"qualified server": {providerData: defaultProviderData, id: "globex/snt_01HZX3", tenant: types.StringValue("globex")},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			identity := newSentryIdentity(testCase.providerData, "apollo", testCase.id)
+			if !identity.Tenant.Equal(testCase.tenant) {
+				t.Errorf("Expected tenant %s, got %s", testCase.tenant, identity.Tenant)
+			}
+			_, unqualified := splitSentryTenant(testCase.id)
+			if identity.SentryType.ValueString() != "apollo" || identity.ID.ValueString() != unqualified {
+				t.Errorf("Unexpected identity %+v", identity)
+			}
+		})
+	}
+}
+
+func TestImportSentryState(t *testing.T) {
+	ctx := context.Background()
+	providerData := &ProviderData{Tenant: "acme"}
+
+	resourceSchema := GetCommonSentrySchema("Healthcare", "test")
+	identitySchema := GetSentryIdentitySchema()
+	identityType := identitySchema.Type().TerraformType(ctx)
+
+	identityValue := func(tenant, sentryType, id *string) tftypes.Value {
+		value := func(s *string) tftypes.Value {
+			if s == nil {
+				return tftypes.NewValue(tftypes.String, nil)
+			}
+			return tftypes.NewValue(tftypes.String, *s)
+		}
+		return tftypes.NewValue(identityType, map[string]tftypes.Value{
+			"tenant":      value(tenant),
+			"sentry_type": value(sentryType),
+			"id":          value(id),
+		})
+	}
+	str := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		id          string
+		identity    tftypes.Value
+		expectError bool
+		expectedID  string
+	}{
+		"by id": {
+			id:         "apollo-hospital-1700000000",
+			identity:   tftypes.NewValue(identityType, nil),
+			expectedID: "apollo-hospital-1700000000",
+		},
+		"by id of another type": {
+			id:          "ra-grid-1700000000",
+			identity:    tftypes.NewValue(identityType, nil),
+			expectError: true,
+		},
+		"by qualified id": {
+			id:         "acme/apollo-hospital-1700000000",
+			identity:   tftypes.NewValue(identityType, nil),
+			expectedID: "apollo-hospital-1700000000",
+		},
+		"by server-assigned id": {
+			id:         "snt_01HZX3",
+			identity:   tftypes.NewValue(identityType, nil),
+			expectedID: "snt_01HZX3",
+		},
+		"by qualified server-assigned id": {
+			id:         "acme/snt_01HZX3",
+			identity:   tftypes.NewValue(identityType, nil),
+			expectedID: "snt_01HZX3",
+		},
+		"by server-assigned id of another tenant": {
+			id:          "globex/snt_01HZX3",
+			identity:    tftypes.NewValue(identityType, nil),
+			expectError: true,
+		},
+		"by id of another tenant": {
+			id:          "globex/apollo-hospital-1700000000",
+			identity:    tftypes.NewValue(identityType, nil),
+			expectError: true,
+		},
+		"by id with an empty tenant": {
+			id:          "/apollo-hospital-1700000000",
+			identity:    tftypes.NewValue(identityType, nil),
+			expectError: true,
+		},
+		"by identity": {
+			identity:   identityValue(str("acme"), str("apollo"), str("apollo-hospital-1700000000")),
+			expectedID: "apollo-hospital-1700000000",
+		},
+		"by identity without optional attributes": {
+			identity:   identityValue(nil, nil, str("apollo-hospital-1700000000")),
+			expectedID: "apollo-hospital-1700000000",
+		},
+		"by identity of another type": {
+			identity:    identityValue(nil, str("ra"), str("apollo-hospital-1700000000")),
+			expectError: true,
+		},
+		"by identity of another tenant": {
+			identity:    identityValue(str("globex"), nil, str("apollo-hospital-1700000000")),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ImportStateRequest{
+				ID:       testCase.id,
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: testCase.identity},
+			}
+			resp := &resource.ImportStateResponse{
+				State:    tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)},
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: tftypes.NewValue(identityType, nil)},
+			}
+
+			importSentryState(ctx, "apollo", providerData, req, resp)
+
+			if testCase.expectError {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id.ValueString() != testCase.expectedID {
+				t.Errorf("Expected id %q, got %q", testCase.expectedID, id.ValueString())
+			}
+
+			var identity SentryIdentityModel
+			resp.Diagnostics.Append(resp.Identity.Get(ctx, &identity)...)
+			if identity.Tenant.ValueString() != "acme" || identity.SentryType.ValueString() != "apollo" {
+				t.Errorf("Unexpected identity %+v", identity)
+			}
+		})
+	}
+}
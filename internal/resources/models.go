@@ -1,18 +1,402 @@
 package resources
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Defaults applied to the typed configuration attributes when they are omitted.
+const (
+	DefaultMonitoringIntervalSeconds = 60
+	DefaultMonitoringMode            = "continuous"
+	DefaultThreatLevel               = "medium"
 )
 
+// MonitoringModes lists the accepted values for monitoring.mode.
+var MonitoringModes = []string{"continuous", "scheduled", "on_demand"}
+
+// SeverityLevels lists the accepted values for alerting.threshold and threat_level.
+var SeverityLevels = []string{"low", "medium", "high", "critical"}
+
+// Keys used for the typed attributes in the flat configuration map exchanged with the Sentinel API.
+const (
+	configKeyMonitoringInterval = "monitoring_interval"
+	configKeyMonitoringMode     = "monitoring_mode"
+	configKeyAlertThreshold     = "alert_threshold"
+	configKeyAlertEmail         = "alert_email"
+	configKeyThreatLevel        = "threat_level"
+)
+
+// typedConfigKeys lists the config keys that are superseded by typed attributes.
+var typedConfigKeys = []string{
+	configKeyMonitoringInterval,
+	configKeyMonitoringMode,
+	configKeyAlertThreshold,
+	configKeyAlertEmail,
+	configKeyThreatLevel,
+}
+
 // SentryResourceModel describes the resource data model that is common to all sentries.
 type SentryResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Sector      types.String `tfsdk:"sector"`
-	Status      types.String `tfsdk:"status"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	Config      types.Map    `tfsdk:"config"`
-	Tags        types.Map    `tfsdk:"tags"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Sector                 types.String `tfsdk:"sector"`
+	Status                 types.String `tfsdk:"status"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	DeletionProtection     types.Bool   `tfsdk:"deletion_protection"`
+	Monitoring             types.Object `tfsdk:"monitoring"`
+	Alerting               types.Object `tfsdk:"alerting"`
+	NotificationChannelIDs types.List   `tfsdk:"notification_channel_ids"`
+	ThreatLevel            types.String `tfsdk:"threat_level"`
+	Config                 types.Map    `tfsdk:"config"`
+	SecretConfigWO         types.Map    `tfsdk:"secret_config_wo"`
+	SecretConfigWOVersion  types.Int64  `tfsdk:"secret_config_wo_version"`
+	Tags                   types.Map    `tfsdk:"tags"`
+	LastUpdated            types.String `tfsdk:"last_updated"`
+}
+
+// MonitoringModel describes the monitoring settings of a sentry.
+type MonitoringModel struct {
+	IntervalSeconds types.Int64  `tfsdk:"interval_seconds"`
+	Mode            types.String `tfsdk:"mode"`
+}
+
+// AttributeTypes returns the attribute types of the monitoring object.
+func (m MonitoringModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"interval_seconds": types.Int64Type,
+		"mode":             types.StringType,
+	}
+}
+
+// AlertingModel describes the alerting settings of a sentry.
+type AlertingModel struct {
+	Threshold types.String `tfsdk:"threshold"`
+	Emails    types.List   `tfsdk:"emails"`
+}
+
+// AttributeTypes returns the attribute types of the alerting object.
+func (m AlertingModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"threshold": types.StringType,
+		"emails":    types.ListType{ElemType: types.StringType},
+	}
+}
+
+// SentryHealthModel describes the live operational state of a sentry.
+type SentryHealthModel struct {
+	LastHeartbeat   types.String  `tfsdk:"last_heartbeat"`
+	SensorsTotal    types.Int64   `tfsdk:"sensors_total"`
+	SensorsOnline   types.Int64   `tfsdk:"sensors_online"`
+	EventsPerMinute types.Float64 `tfsdk:"events_per_minute"`
+	ModelVersion    types.String  `tfsdk:"model_version"`
+	LastError       types.String  `tfsdk:"last_error"`
+	HealthScore     types.Float64 `tfsdk:"health_score"`
+}
+
+// AttributeTypes returns the attribute types of the health object.
+func (m SentryHealthModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"last_heartbeat":    types.StringType,
+		"sensors_total":     types.Int64Type,
+		"sensors_online":    types.Int64Type,
+		"events_per_minute": types.Float64Type,
+		"model_version":     types.StringType,
+		"last_error":        types.StringType,
+		"health_score":      types.Float64Type,
+	}
+}
+
+// NewSentryHealthModel converts the health reported by the Sentinel API.
+func NewSentryHealthModel(health client.SentryHealth) SentryHealthModel {
+	model := SentryHealthModel{
+		LastHeartbeat:   types.StringNull(),
+		SensorsTotal:    types.Int64Value(health.SensorsTotal),
+		SensorsOnline:   types.Int64Value(health.SensorsOnline),
+		EventsPerMinute: types.Float64Value(health.EventsPerMinute),
+		ModelVersion:    types.StringValue(health.ModelVersion),
+		LastError:       types.StringNull(),
+		HealthScore:     types.Float64Value(health.HealthScore),
+	}
+	if !health.LastHeartbeat.IsZero() {
+		model.LastHeartbeat = types.StringValue(health.LastHeartbeat.Format(time.RFC3339))
+	}
+	if health.LastError != "" {
+		model.LastError = types.StringValue(health.LastError)
+	}
+	return model
+}
+
+// DefaultMonitoring returns the monitoring object used when the attribute is omitted.
+func DefaultMonitoring() types.Object {
+	return types.ObjectValueMust(MonitoringModel{}.AttributeTypes(), map[string]attr.Value{
+		"interval_seconds": types.Int64Value(DefaultMonitoringIntervalSeconds),
+		"mode":             types.StringValue(DefaultMonitoringMode),
+	})
+}
+
+// APISentry converts the model into the sentry of the given type sent to the
+// Sentinel API.
+func (m SentryResourceModel) APISentry(ctx context.Context, sentryType string) (client.Sentry, diag.Diagnostics) {
+	sentry := client.Sentry{
+		Type:               sentryType,
+		Name:               m.Name.ValueString(),
+		Description:        m.Description.ValueString(),
+		Sector:             sentrySector(sentryType),
+		Enabled:            m.Enabled.ValueBool(),
+		DeletionProtection: m.DeletionProtection.ValueBool(),
+	}
+
+	config, diags := m.APIConfig(ctx)
+	if diags.HasError() {
+		return sentry, diags
+	}
+	sentry.Config = config
+
+	channelIDs, channelDiags := m.APINotificationChannelIDs(ctx)
+	diags.Append(channelDiags...)
+	sentry.NotificationChannelIDs = channelIDs
+
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		diags.Append(m.Tags.ElementsAs(ctx, &sentry.Tags, false)...)
+	}
+
+	return sentry, diags
+}
+
+// APIConfig converts the typed attributes and the free-form config map into the
+// flat configuration map understood by the Sentinel API. Typed attributes take
+// precedence over config keys with the same meaning.
+func (m SentryResourceModel) APIConfig(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := make(map[string]string)
+
+	if !m.Config.IsNull() && !m.Config.IsUnknown() {
+		var config map[string]string
+		diags.Append(m.Config.ElementsAs(ctx, &config, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for key, value := range config {
+			result[key] = value
+		}
+	}
+
+	if !m.Monitoring.IsNull() && !m.Monitoring.IsUnknown() {
+		var monitoring MonitoringModel
+		diags.Append(m.Monitoring.As(ctx, &monitoring, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if !monitoring.IntervalSeconds.IsNull() {
+			result[configKeyMonitoringInterval] = strconv.FormatInt(monitoring.IntervalSeconds.ValueInt64(), 10)
+		}
+		if !monitoring.Mode.IsNull() {
+			result[configKeyMonitoringMode] = monitoring.Mode.ValueString()
+		}
+	}
+
+	if !m.Alerting.IsNull() && !m.Alerting.IsUnknown() {
+		var alerting AlertingModel
+		diags.Append(m.Alerting.As(ctx, &alerting, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		// An unset threshold is omitted so that the sentry group threshold applies.
+		if !alerting.Threshold.IsNull() && !alerting.Threshold.IsUnknown() {
+			result[configKeyAlertThreshold] = alerting.Threshold.ValueString()
+		}
+		if !alerting.Emails.IsNull() && !alerting.Emails.IsUnknown() {
+			var emails []string
+			diags.Append(alerting.Emails.ElementsAs(ctx, &emails, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			result[configKeyAlertEmail] = strings.Join(emails, ",")
+		}
+	}
+
+	if !m.ThreatLevel.IsNull() && !m.ThreatLevel.IsUnknown() {
+		result[configKeyThreatLevel] = m.ThreatLevel.ValueString()
+	}
+
+	return result, diags
+}
+
+// APINotificationChannelIDs returns the IDs of the notification channels the
+// sentry delivers alerts to.
+func (m SentryResourceModel) APINotificationChannelIDs(ctx context.Context) ([]string, diag.Diagnostics) {
+	if m.NotificationChannelIDs.IsNull() || m.NotificationChannelIDs.IsUnknown() {
+		return nil, nil
+	}
+
+	var ids []string
+	diags := m.NotificationChannelIDs.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// SetAPIConfig populates the typed attributes and the free-form config map from
+// the flat configuration map returned by the Sentinel API. Keys that have a typed
+// equivalent are moved out of the config map.
+func (m *SentryResourceModel) SetAPIConfig(ctx context.Context, apiConfig map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	monitoring := MonitoringModel{
+		IntervalSeconds: types.Int64Value(DefaultMonitoringIntervalSeconds),
+		Mode:            types.StringValue(DefaultMonitoringMode),
+	}
+	alerting := AlertingModel{
+		Threshold: types.StringNull(),
+		Emails:    types.ListNull(types.StringType),
+	}
+	threatLevel := types.StringValue(DefaultThreatLevel)
+	config := make(map[string]string)
+
+	for key, value := range apiConfig {
+		switch key {
+		case configKeyMonitoringInterval:
+			interval, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("monitoring").AtName("interval_seconds"),
+					"Invalid Monitoring Interval",
+					fmt.Sprintf("The Sentinel API returned a non-numeric %s value %q: %s", key, value, err),
+				)
+				continue
+			}
+			monitoring.IntervalSeconds = types.Int64Value(interval)
+		case configKeyMonitoringMode:
+			monitoring.Mode = types.StringValue(value)
+		case configKeyAlertThreshold:
+			alerting.Threshold = types.StringValue(value)
+		case configKeyAlertEmail:
+			var emails []attr.Value
+			for _, email := range strings.Split(value, ",") {
+				if email = strings.TrimSpace(email); email != "" {
+					emails = append(emails, types.StringValue(email))
+				}
+			}
+			alerting.Emails = types.ListValueMust(types.StringType, emails)
+		case configKeyThreatLevel:
+			threatLevel = types.StringValue(value)
+		default:
+			config[key] = value
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	m.Monitoring, d = types.ObjectValueFrom(ctx, monitoring.AttributeTypes(), monitoring)
+	diags.Append(d...)
+	m.Alerting, d = types.ObjectValueFrom(ctx, alerting.AttributeTypes(), alerting)
+	diags.Append(d...)
+	m.ThreatLevel = threatLevel
+
+	if len(config) == 0 {
+		m.Config = types.MapNull(types.StringType)
+	} else {
+		m.Config, d = types.MapValueFrom(ctx, types.StringType, config)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// SetAPISentry populates the model from a sentry returned by the Sentinel API.
+// Write-only attributes are never returned by the API and are left null, and
+// secret_config_wo_version, which only exists in Terraform, is kept.
+func (m *SentryResourceModel) SetAPISentry(ctx context.Context, sentry client.Sentry) diag.Diagnostics {
+	m.ID = types.StringValue(sentry.ID)
+	m.Name = types.StringValue(sentry.Name)
+	m.Description = types.StringNull()
+	if sentry.Description != "" {
+		m.Description = types.StringValue(sentry.Description)
+	}
+	m.Sector = types.StringValue(sentry.Sector)
+	m.Status = types.StringValue(sentry.Status)
+	m.Enabled = types.BoolValue(sentry.Enabled)
+	m.DeletionProtection = types.BoolValue(sentry.DeletionProtection)
+	m.SecretConfigWO = types.MapNull(types.StringType)
+	m.LastUpdated = types.StringNull()
+	if !sentry.UpdatedAt.IsZero() {
+		m.LastUpdated = types.StringValue(sentry.UpdatedAt.Format(time.RFC3339))
+	}
+
+	diags := m.SetAPIConfig(ctx, sentry.Config)
+
+	m.NotificationChannelIDs = types.ListNull(types.StringType)
+	if len(sentry.NotificationChannelIDs) > 0 {
+		var d diag.Diagnostics
+		m.NotificationChannelIDs, d = types.ListValueFrom(ctx, types.StringType, sentry.NotificationChannelIDs)
+		diags.Append(d...)
+	}
+
+	m.Tags = types.MapNull(types.StringType)
+	if len(sentry.Tags) > 0 {
+		var d diag.Diagnostics
+		m.Tags, d = types.MapValueFrom(ctx, types.StringType, sentry.Tags)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// readSecretConfig reads the write-only secret config from the configuration.
+// Write-only values are only available there, never in the plan or state.
+func readSecretConfig(ctx context.Context, config tfsdk.Config) (map[string]string, diag.Diagnostics) {
+	var secrets types.Map
+	diags := config.GetAttribute(ctx, path.Root("secret_config_wo"), &secrets)
+	if diags.HasError() || secrets.IsNull() || secrets.IsUnknown() {
+		return nil, diags
+	}
+
+	var result map[string]string
+	diags.Append(secrets.ElementsAs(ctx, &result, false)...)
+	return result, diags
+}
+
+// readSecretConfigForUpdate reads the write-only secret config when
+// secret_config_wo_version changed, and returns nil otherwise so that unchanged
+// secrets are not sent again.
+func readSecretConfigForUpdate(ctx context.Context, req resource.UpdateRequest) (map[string]string, diag.Diagnostics) {
+	var planVersion, stateVersion types.Int64
+
+	diags := req.Plan.GetAttribute(ctx, path.Root("secret_config_wo_version"), &planVersion)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("secret_config_wo_version"), &stateVersion)...)
+	if diags.HasError() || planVersion.Equal(stateVersion) {
+		return nil, diags
+	}
+
+	secrets, readDiags := readSecretConfig(ctx, req.Config)
+	diags.Append(readDiags...)
+	return secrets, diags
+}
+
+// secretConfigKeys returns the sorted keys of a secret config for logging
+// without exposing the secret values.
+func secretConfigKeys(secrets map[string]string) []string {
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
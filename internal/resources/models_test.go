@@ -0,0 +1,242 @@
+package resources
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSentryResourceModelAPIConfig(t *testing.T) {
+	ctx := context.Background()
+
+	model := SentryResourceModel{
+		Monitoring: types.ObjectValueMust(MonitoringModel{}.AttributeTypes(), map[string]attr.Value{
+			"interval_seconds": types.Int64Value(120),
+			"mode":             types.StringValue("scheduled"),
+		}),
+		Alerting: types.ObjectValueMust(AlertingModel{}.AttributeTypes(), map[string]attr.Value{
+			"threshold": types.StringValue("high"),
+			"emails": types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("soc@example.com"),
+				types.StringValue("oncall@example.com"),
+			}),
+		}),
+		ThreatLevel: types.StringValue("critical"),
+		Config: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"region": types.StringValue("us-east-1"),
+		}),
+	}
+
+	apiConfig, diags := model.APIConfig(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := map[string]string{
+		"monitoring_interval": "120",
+		"monitoring_mode":     "scheduled",
+		"alert_threshold":     "high",
+		"alert_email":         "soc@example.com,oncall@example.com",
+		"threat_level":        "critical",
+		"region":              "us-east-1",
+	}
+	if len(apiConfig) != len(expected) {
+		t.Fatalf("Expected %d config entries, got %d: %v", len(expected), len(apiConfig), apiConfig)
+	}
+	for key, value := range expected {
+		if apiConfig[key] != value {
+			t.Errorf("Expected config[%q] to be %q, got %q", key, value, apiConfig[key])
+		}
+	}
+}
+
+func TestSentryResourceModelSetAPIConfig(t *testing.T) {
+	ctx := context.Background()
+
+	var model SentryResourceModel
+	diags := model.SetAPIConfig(ctx, map[string]string{
+		"monitoring_interval": "30",
+		"alert_email":         "soc@example.com",
+		"grid_region":         "northeast",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var monitoring MonitoringModel
+	if diags := model.Monitoring.As(ctx, &monitoring, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if monitoring.IntervalSeconds.ValueInt64() != 30 {
+		t.Errorf("Expected interval_seconds to be 30, got %d", monitoring.IntervalSeconds.ValueInt64())
+	}
+	if monitoring.Mode.ValueString() != DefaultMonitoringMode {
+		t.Errorf("Expected mode to default to %q, got %q", DefaultMonitoringMode, monitoring.Mode.ValueString())
+	}
+
+	if model.ThreatLevel.ValueString() != DefaultThreatLevel {
+		t.Errorf("Expected threat_level to default to %q, got %q", DefaultThreatLevel, model.ThreatLevel.ValueString())
+	}
+
+	config := model.Config.Elements()
+	if len(config) != 1 || config["grid_region"] != types.StringValue("northeast") {
+		t.Errorf("Expected only grid_region to remain in config, got %v", config)
+	}
+}
+
+func TestSentryResourceModelSetAPIConfigInvalidInterval(t *testing.T) {
+	var model SentryResourceModel
+	diags := model.SetAPIConfig(context.Background(), map[string]string{
+		"monitoring_interval": "sixty",
+	})
+	if !diags.HasError() {
+		t.Fatal("Expected an error for a non-numeric monitoring_interval")
+	}
+}
+
+func TestReadSecretConfigForUpdate(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := GetCommonSentrySchema("Healthcare", "test")
+
+	version := func(v int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, v) }
+	secrets := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"api_token": tftypes.NewValue(tftypes.String, "s3cr3t"),
+	})
+	nullSecrets := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+
+	testCases := map[string]struct {
+		secrets      tftypes.Value
+		stateVersion tftypes.Value
+		planVersion  tftypes.Value
+		expected     map[string]string
+	}{
+		"version unchanged": {secrets: secrets, stateVersion: version(1), planVersion: version(1)},
+		"version bumped":    {secrets: secrets, stateVersion: version(1), planVersion: version(2), expected: map[string]string{"api_token": "s3cr3t"}},
+		"version set":       {secrets: secrets, stateVersion: tftypes.NewValue(tftypes.Number, nil), planVersion: version(1), expected: map[string]string{"api_token": "s3cr3t"}},
+		"null secrets":      {secrets: nullSecrets, stateVersion: version(1), planVersion: version(2)},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			value := func(version tftypes.Value, secrets tftypes.Value) tftypes.Value {
+				return sentryValue(ctx, map[string]tftypes.Value{
+					"name":                     tftypes.NewValue(tftypes.String, "hospital"),
+					"secret_config_wo":         secrets,
+					"secret_config_wo_version": version,
+				})
+			}
+			req := resource.UpdateRequest{
+				Config: tfsdk.Config{Schema: resourceSchema, Raw: value(testCase.planVersion, testCase.secrets)},
+				Plan:   tfsdk.Plan{Schema: resourceSchema, Raw: value(testCase.planVersion, nullSecrets)},
+				State:  tfsdk.State{Schema: resourceSchema, Raw: value(testCase.stateVersion, nullSecrets)},
+			}
+
+			result, diags := readSecretConfigForUpdate(ctx, req)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !maps.Equal(result, testCase.expected) {
+				t.Errorf("Expected secrets %v, got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestSentryResourceModelAPINotificationChannelIDs(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		channelIDs types.List
+		expected   []string
+	}{
+		"null":    {channelIDs: types.ListNull(types.StringType)},
+		"unknown": {channelIDs: types.ListUnknown(types.StringType)},
+		"set": {
+			channelIDs: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("nc-soc"), types.StringValue("nc-oncall")}),
+			expected:   []string{"nc-soc", "nc-oncall"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model := SentryResourceModel{NotificationChannelIDs: testCase.channelIDs}
+
+			channelIDs, diags := model.APINotificationChannelIDs(ctx)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !slices.Equal(channelIDs, testCase.expected) {
+				t.Errorf("Expected channel IDs %v, got %v", testCase.expected, channelIDs)
+			}
+		})
+	}
+}
+
+func TestSentryResourceModelSetAPISentryRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	model := SentryResourceModel{
+		Name:                   types.StringValue("hospital"),
+		Enabled:                types.BoolValue(true),
+		DeletionProtection:     types.BoolValue(true),
+		NotificationChannelIDs: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("nc-soc"), types.StringValue("nc-oncall")}),
+		Tags:                   types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("soc")}),
+	}
+
+	sentry, diags := model.APISentry(ctx, "apollo")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !slices.Equal(sentry.NotificationChannelIDs, []string{"nc-soc", "nc-oncall"}) {
+		t.Errorf("Expected notification channel IDs to be sent, got %v", sentry.NotificationChannelIDs)
+	}
+
+	sentry.ID = "apollo-hospital-1700000000"
+	var result SentryResourceModel
+	if diags := result.SetAPISentry(ctx, sentry); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !result.NotificationChannelIDs.Equal(model.NotificationChannelIDs) {
+		t.Errorf("Expected notification_channel_ids %s, got %s", model.NotificationChannelIDs, result.NotificationChannelIDs)
+	}
+	if !result.Tags.Equal(model.Tags) || !result.DeletionProtection.Equal(model.DeletionProtection) || result.Sector.ValueString() != "Healthcare" {
+		t.Errorf("Unexpected round trip result %+v", result)
+	}
+}
+
+func TestSentryResourceModelAPIConfigUnsetThreshold(t *testing.T) {
+	ctx := context.Background()
+
+	for name, threshold := range map[string]types.String{
+		"null":    types.StringNull(),
+		"unknown": types.StringUnknown(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			model := SentryResourceModel{
+				Alerting: types.ObjectValueMust(AlertingModel{}.AttributeTypes(), map[string]attr.Value{
+					"threshold": threshold,
+					"emails":    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("soc@example.com")}),
+				}),
+			}
+
+			apiConfig, diags := model.APIConfig(ctx)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if value, ok := apiConfig[configKeyAlertThreshold]; ok {
+				t.Errorf("Expected alert_threshold to be omitted so the group threshold applies, got %q", value)
+			}
+			if apiConfig[configKeyAlertEmail] != "soc@example.com" {
+				t.Errorf("Expected alert_email to be sent, got %v", apiConfig)
+			}
+		})
+	}
+}
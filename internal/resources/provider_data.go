@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ProviderData is the provider-level configuration handed to every resource.
+type ProviderData struct {
+	// Client is the Sentinel API client built from the provider configuration.
+	Client *client.Client
+	// Tenant is the tenant the provider operates in, empty when not configured.
+	Tenant string
+	// DeletionProtection rejects destroy plans for protected sentries.
+	DeletionProtection bool
+	// ProtectedTags marks sentries carrying any of these tag key/value pairs as
+	// protected, in addition to the sentries of critical sectors.
+	ProtectedTags map[string]string
+}
+
+// defaultProviderData is used when a resource has not been configured by the provider.
+var defaultProviderData = &ProviderData{DeletionProtection: true}
+
+// configureProviderData extracts the provider data from a resource configure request.
+func configureProviderData(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *ProviderData {
+	return providerDataFrom(req.ProviderData, &resp.Diagnostics)
+}
+
+// configureEphemeralProviderData extracts the provider data from an ephemeral resource configure request.
+func configureEphemeralProviderData(req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) *ProviderData {
+	return providerDataFrom(req.ProviderData, &resp.Diagnostics)
+}
+
+// configureDataSourceProviderData extracts the provider data from a data source configure request.
+func configureDataSourceProviderData(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *ProviderData {
+	return providerDataFrom(req.ProviderData, &resp.Diagnostics)
+}
+
+// configureActionProviderData extracts the provider data from an action configure request.
+func configureActionProviderData(req action.ConfigureRequest, resp *action.ConfigureResponse) *ProviderData {
+	return providerDataFrom(req.ProviderData, &resp.Diagnostics)
+}
+
+// providerDataFrom asserts the type of the provider data passed to a configure request.
+func providerDataFrom(data any, diags *diag.Diagnostics) *ProviderData {
+	if data == nil {
+		return defaultProviderData
+	}
+
+	providerData, ok := data.(*ProviderData)
+	if !ok {
+		diags.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *resources.ProviderData, got: %T. Please report this issue to the provider developers.", data),
+		)
+		return defaultProviderData
+	}
+
+	return providerData
+}
+
+// apiClient returns the Sentinel API client, adding an error when the provider
+// has not been configured yet.
+func (d *ProviderData) apiClient(diags *diag.Diagnostics) *client.Client {
+	if d == nil || d.Client == nil {
+		diags.AddError(
+			"Unconfigured Sentinel API Client",
+			"The provider has not been configured yet. Please report this issue to the provider developers.",
+		)
+		return nil
+	}
+	return d.Client
+}
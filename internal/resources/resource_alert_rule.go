@@ -0,0 +1,506 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &AlertRuleResource{}
+	_ resource.ResourceWithConfigure        = &AlertRuleResource{}
+	_ resource.ResourceWithImportState      = &AlertRuleResource{}
+	_ resource.ResourceWithConfigValidators = &AlertRuleResource{}
+)
+
+// Defaults applied to the alert rule conditions when they are omitted.
+const (
+	DefaultAlertRuleEventCount = 1
+	DefaultAlertRuleWindow     = "5m"
+)
+
+// NewAlertRuleResource is a helper function to simplify the provider implementation.
+func NewAlertRuleResource() resource.Resource {
+	return &AlertRuleResource{}
+}
+
+// AlertRuleResource manages a rule that raises alerts for a set of sentries.
+type AlertRuleResource struct {
+	providerData *ProviderData
+}
+
+// AlertRuleResourceModel describes the alert rule resource data model.
+type AlertRuleResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	Scope                  types.Object `tfsdk:"scope"`
+	Conditions             types.Object `tfsdk:"conditions"`
+	ThrottleWindow         types.String `tfsdk:"throttle_window"`
+	DedupWindow            types.String `tfsdk:"dedup_window"`
+	NotificationChannelIDs types.List   `tfsdk:"notification_channel_ids"`
+	LastUpdated            types.String `tfsdk:"last_updated"`
+}
+
+// AlertRuleScopeModel describes the sentries an alert rule applies to.
+type AlertRuleScopeModel struct {
+	SentryIDs types.List   `tfsdk:"sentry_ids"`
+	Sector    types.String `tfsdk:"sector"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+// AttributeTypes returns the attribute types of the scope object.
+func (m AlertRuleScopeModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sentry_ids": types.ListType{ElemType: types.StringType},
+		"sector":     types.StringType,
+		"tags":       types.MapType{ElemType: types.StringType},
+	}
+}
+
+// AlertRuleConditionsModel describes when an alert rule fires.
+type AlertRuleConditionsModel struct {
+	MinSeverity types.String `tfsdk:"min_severity"`
+	EventCount  types.Int64  `tfsdk:"event_count"`
+	Window      types.String `tfsdk:"window"`
+	Categories  types.List   `tfsdk:"categories"`
+}
+
+// AttributeTypes returns the attribute types of the conditions object.
+func (m AlertRuleConditionsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"min_severity": types.StringType,
+		"event_count":  types.Int64Type,
+		"window":       types.StringType,
+		"categories":   types.ListType{ElemType: types.StringType},
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *AlertRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *AlertRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an alert rule. The rule applies to a set of sentries selected by ID, sector or tags, " +
+			"fires when its conditions are met and delivers the alert to notification channels.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the alert rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the alert rule.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the alert rule.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the alert rule is evaluated. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"scope": schema.SingleNestedAttribute{
+				Description: "The sentries the rule applies to. Exactly one of sentry_ids, sector and tags must be set.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"sentry_ids": schema.ListAttribute{
+						Description: "The IDs of the sentries the rule applies to.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+						},
+					},
+					"sector": schema.StringAttribute{
+						Description: "Applies the rule to all sentries of this sector, including ones created later.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(catalog.Sectors()...),
+						},
+					},
+					"tags": schema.MapAttribute{
+						Description: "Applies the rule to all sentries carrying all of these tags, including ones created later.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"conditions": schema.SingleNestedAttribute{
+				Description: "When the rule fires: at least event_count events of at least min_severity within window, " +
+					"optionally limited to some detection categories.",
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"min_severity": schema.StringAttribute{
+						Description: "Minimum severity of the events counted: low, medium, high or critical.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(SeverityLevels...),
+						},
+					},
+					"event_count": schema.Int64Attribute{
+						Description: "Number of events within window that fire the rule. Defaults to 1.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(DefaultAlertRuleEventCount),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100000),
+						},
+					},
+					"window": schema.StringAttribute{
+						Description: "The sliding window events are counted in, e.g. 5m or 1h. Defaults to 5m.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(DefaultAlertRuleWindow),
+						Validators: []validator.String{
+							durationValidator{min: time.Minute, max: 24 * time.Hour},
+						},
+					},
+					"categories": schema.ListAttribute{
+						Description: "Only count events of these detection categories, e.g. intrusion or malware. " +
+							"When omitted, events of all categories are counted.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+				},
+			},
+			"throttle_window": schema.StringAttribute{
+				Description: "After firing, the rule does not fire again for the same sentry within this duration, e.g. 15m.",
+				Optional:    true,
+				Validators: []validator.String{
+					durationValidator{min: time.Minute, max: 7 * 24 * time.Hour},
+				},
+			},
+			"dedup_window": schema.StringAttribute{
+				Description: "Alerts with the same sentry, category and severity within this duration are merged into one, e.g. 1h.",
+				Optional:    true,
+				Validators: []validator.String{
+					durationValidator{min: time.Minute, max: 7 * 24 * time.Hour},
+				},
+			},
+			"notification_channel_ids": schema.ListAttribute{
+				Description: "The IDs of the sentinel_notification_channel resources alerts are delivered to.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update to the alert rule (RFC3339 format).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires exactly one way of selecting the sentries.
+func (r *AlertRuleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("scope").AtName("sentry_ids"),
+			path.MatchRoot("scope").AtName("sector"),
+			path.MatchRoot("scope").AtName("tags"),
+		),
+	}
+}
+
+// Configure adds the provider configuration to the resource.
+func (r *AlertRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// Create creates the alert rule and sets the initial Terraform state.
+func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AlertRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := plan.APIAlertRule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating alert rule", map[string]interface{}{
+		"name":                     rule.Name,
+		"notification_channel_ids": rule.NotificationChannelIDs,
+	})
+
+	created, err := apiClient.CreateAlertRule(ctx, rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Alert Rule", "Could not create alert rule "+rule.Name+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPIAlertRule(ctx, *created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data, so that changes
+// made in the console show up as drift, and removes the alert rule from the
+// state when it was deleted outside of Terraform.
+func (r *AlertRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AlertRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading alert rule", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	rule, err := apiClient.GetAlertRule(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Alert Rule", "Could not read alert rule "+state.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.SetAPIAlertRule(ctx, *rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the alert rule and sets the updated Terraform state on success.
+func (r *AlertRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AlertRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := plan.APIAlertRule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating alert rule", map[string]interface{}{
+		"id":                       plan.ID.ValueString(),
+		"name":                     rule.Name,
+		"notification_channel_ids": rule.NotificationChannelIDs,
+	})
+
+	updated, err := apiClient.UpdateAlertRule(ctx, plan.ID.ValueString(), rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Alert Rule", "Could not update alert rule "+plan.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPIAlertRule(ctx, *updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the alert rule and removes the Terraform state on success.
+func (r *AlertRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AlertRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting alert rule", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	err := apiClient.DeleteAlertRule(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Alert Rule", "Could not delete alert rule "+state.ID.ValueString()+": "+err.Error())
+	}
+}
+
+// ImportState imports an existing alert rule into Terraform by ID.
+func (r *AlertRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// APIAlertRule converts the model into the alert rule sent to the Sentinel API.
+func (m AlertRuleResourceModel) APIAlertRule(ctx context.Context) (client.AlertRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rule := client.AlertRule{
+		Name:           m.Name.ValueString(),
+		Description:    m.Description.ValueString(),
+		Enabled:        m.Enabled.ValueBool(),
+		ThrottleWindow: m.ThrottleWindow.ValueString(),
+		DedupWindow:    m.DedupWindow.ValueString(),
+	}
+
+	var scope AlertRuleScopeModel
+	diags.Append(m.Scope.As(ctx, &scope, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return rule, diags
+	}
+	rule.Scope.Sector = scope.Sector.ValueString()
+	if !scope.SentryIDs.IsNull() {
+		diags.Append(scope.SentryIDs.ElementsAs(ctx, &rule.Scope.SentryIDs, false)...)
+	}
+	if !scope.Tags.IsNull() {
+		diags.Append(scope.Tags.ElementsAs(ctx, &rule.Scope.Tags, false)...)
+	}
+
+	var conditions AlertRuleConditionsModel
+	diags.Append(m.Conditions.As(ctx, &conditions, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return rule, diags
+	}
+	rule.Conditions = client.AlertRuleConditions{
+		MinSeverity: conditions.MinSeverity.ValueString(),
+		EventCount:  conditions.EventCount.ValueInt64(),
+		Window:      conditions.Window.ValueString(),
+	}
+	if !conditions.Categories.IsNull() {
+		diags.Append(conditions.Categories.ElementsAs(ctx, &rule.Conditions.Categories, false)...)
+	}
+
+	diags.Append(m.NotificationChannelIDs.ElementsAs(ctx, &rule.NotificationChannelIDs, false)...)
+
+	return rule, diags
+}
+
+// SetAPIAlertRule populates the model from an alert rule returned by the Sentinel API.
+func (m *AlertRuleResourceModel) SetAPIAlertRule(ctx context.Context, rule client.AlertRule) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	// Durations are kept as planned when the API returns them spelled differently.
+	var current AlertRuleConditionsModel
+	if !m.Conditions.IsNull() && !m.Conditions.IsUnknown() {
+		diags.Append(m.Conditions.As(ctx, &current, basetypes.ObjectAsOptions{})...)
+	}
+
+	m.ID = types.StringValue(rule.ID)
+	m.Name = types.StringValue(rule.Name)
+	m.Description = optionalString(rule.Description)
+	m.Enabled = types.BoolValue(rule.Enabled)
+	m.ThrottleWindow = durationValue(m.ThrottleWindow, rule.ThrottleWindow)
+	m.DedupWindow = durationValue(m.DedupWindow, rule.DedupWindow)
+	m.LastUpdated = types.StringNull()
+	if !rule.UpdatedAt.IsZero() {
+		m.LastUpdated = types.StringValue(rule.UpdatedAt.Format(time.RFC3339))
+	}
+
+	scope := AlertRuleScopeModel{
+		SentryIDs: types.ListNull(types.StringType),
+		Sector:    optionalString(rule.Scope.Sector),
+		Tags:      types.MapNull(types.StringType),
+	}
+	if len(rule.Scope.SentryIDs) > 0 {
+		scope.SentryIDs, d = types.ListValueFrom(ctx, types.StringType, rule.Scope.SentryIDs)
+		diags.Append(d...)
+	}
+	if len(rule.Scope.Tags) > 0 {
+		scope.Tags, d = types.MapValueFrom(ctx, types.StringType, rule.Scope.Tags)
+		diags.Append(d...)
+	}
+	m.Scope, d = types.ObjectValueFrom(ctx, scope.AttributeTypes(), scope)
+	diags.Append(d...)
+
+	conditions := AlertRuleConditionsModel{
+		MinSeverity: types.StringValue(rule.Conditions.MinSeverity),
+		EventCount:  types.Int64Value(rule.Conditions.EventCount),
+		Window:      durationValue(current.Window, rule.Conditions.Window),
+		Categories:  types.ListNull(types.StringType),
+	}
+	if len(rule.Conditions.Categories) > 0 {
+		conditions.Categories, d = types.ListValueFrom(ctx, types.StringType, rule.Conditions.Categories)
+		diags.Append(d...)
+	}
+	m.Conditions, d = types.ObjectValueFrom(ctx, conditions.AttributeTypes(), conditions)
+	diags.Append(d...)
+
+	m.NotificationChannelIDs, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(rule.NotificationChannelIDs))
+	diags.Append(d...)
+
+	return diags
+}
+
+// optionalString returns a null string for empty values, which the Sentinel
+// API uses for omitted optional attributes.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
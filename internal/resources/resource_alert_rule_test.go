@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlertRuleResourceModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	model := AlertRuleResourceModel{
+		Name:        types.StringValue("energy-brute-force"),
+		Description: types.StringNull(),
+		Enabled:     types.BoolValue(true),
+		Scope: types.ObjectValueMust(AlertRuleScopeModel{}.AttributeTypes(), map[string]attr.Value{
+			"sentry_ids": types.ListNull(types.StringType),
+			"sector":     types.StringValue("Energy"),
+			"tags":       types.MapNull(types.StringType),
+		}),
+		Conditions: types.ObjectValueMust(AlertRuleConditionsModel{}.AttributeTypes(), map[string]attr.Value{
+			"min_severity": types.StringValue("high"),
+			"event_count":  types.Int64Value(5),
+			"window":       types.StringValue("10m"),
+			"categories":   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("intrusion")}),
+		}),
+		ThrottleWindow:         types.StringValue("30m"),
+		DedupWindow:            types.StringNull(),
+		NotificationChannelIDs: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("nc-123")}),
+	}
+
+	rule, diags := model.APIAlertRule(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if rule.Scope.Sector != "Energy" || rule.Scope.SentryIDs != nil || rule.Scope.Tags != nil {
+		t.Errorf("Unexpected scope: %+v", rule.Scope)
+	}
+	if rule.Conditions.MinSeverity != "high" || rule.Conditions.EventCount != 5 || rule.Conditions.Window != "10m" {
+		t.Errorf("Unexpected conditions: %+v", rule.Conditions)
+	}
+	if len(rule.Conditions.Categories) != 1 || rule.Conditions.Categories[0] != "intrusion" {
+		t.Errorf("Unexpected categories: %v", rule.Conditions.Categories)
+	}
+	if rule.ThrottleWindow != "30m" || rule.DedupWindow != "" {
+		t.Errorf("Unexpected windows: throttle %q, dedup %q", rule.ThrottleWindow, rule.DedupWindow)
+	}
+
+	// A rule read back unchanged must not show drift.
+	rule.ID = "ar-123"
+	rule.UpdatedAt = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	read := model
+	diags = read.SetAPIAlertRule(ctx, rule)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if read.ID.ValueString() != "ar-123" {
+		t.Errorf("Expected ID ar-123, got %s", read.ID)
+	}
+	if read.LastUpdated.ValueString() != "2025-01-02T03:04:05Z" {
+		t.Errorf("Expected last_updated 2025-01-02T03:04:05Z, got %s", read.LastUpdated)
+	}
+	for name, pair := range map[string][2]attr.Value{
+		"description":              {model.Description, read.Description},
+		"scope":                    {model.Scope, read.Scope},
+		"conditions":               {model.Conditions, read.Conditions},
+		"throttle_window":          {model.ThrottleWindow, read.ThrottleWindow},
+		"dedup_window":             {model.DedupWindow, read.DedupWindow},
+		"notification_channel_ids": {model.NotificationChannelIDs, read.NotificationChannelIDs},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			t.Errorf("Expected %s to be %s, got %s", name, pair[0], pair[1])
+		}
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	v := durationValidator{min: time.Minute, max: time.Hour}
+
+	for value, valid := range map[string]bool{
+		"5m":    true,
+		"1h":    true,
+		"90s":   true,
+		"30s":   false,
+		"2h":    false,
+		"5":     false,
+		"5 min": false,
+	} {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("window"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("Expected %q valid=%t, got diagnostics %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestAlertRuleResourceModelNormalizedDurations(t *testing.T) {
+	ctx := context.Background()
+
+	conditions := func(window string) types.Object {
+		return types.ObjectValueMust(AlertRuleConditionsModel{}.AttributeTypes(), map[string]attr.Value{
+			"min_severity": types.StringValue("high"),
+			"event_count":  types.Int64Value(5),
+			"window":       types.StringValue(window),
+			"categories":   types.ListNull(types.StringType),
+		})
+	}
+	model := AlertRuleResourceModel{
+		Scope: types.ObjectValueMust(AlertRuleScopeModel{}.AttributeTypes(), map[string]attr.Value{
+			"sentry_ids": types.ListNull(types.StringType),
+			"sector":     types.StringValue("Energy"),
+			"tags":       types.MapNull(types.StringType),
+		}),
+		Conditions:             conditions("60m"),
+		ThrottleWindow:         types.StringValue("90m"),
+		DedupWindow:            types.StringValue("1h"),
+		NotificationChannelIDs: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("nc-123")}),
+	}
+
+	// The API spells the window and throttle window differently but keeps
+	// their durations; the dedup window was changed outside Terraform.
+	rule, diags := model.APIAlertRule(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	rule.Conditions.Window = "1h0m0s"
+	rule.ThrottleWindow = "1h30m0s"
+	rule.DedupWindow = "2h0m0s"
+
+	read := model
+	diags = read.SetAPIAlertRule(ctx, rule)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !read.Conditions.Equal(conditions("60m")) {
+		t.Errorf("Expected the planned window to be kept, got %s", read.Conditions)
+	}
+	if read.ThrottleWindow.ValueString() != "90m" {
+		t.Errorf("Expected the planned throttle window to be kept, got %s", read.ThrottleWindow)
+	}
+	if read.DedupWindow.ValueString() != "2h0m0s" {
+		t.Errorf("Expected the changed dedup window to be read, got %s", read.DedupWindow)
+	}
+}
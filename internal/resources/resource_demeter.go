@@ -2,13 +2,9 @@ package resources
 
 import (
 "context"
-"fmt"
-"time"
 
-"github.com/hashicorp/terraform-plugin-framework/path"
+"github.com/hashicorp/terraform-plugin-framework/list"
 "github.com/hashicorp/terraform-plugin-framework/resource"
-"github.com/hashicorp/terraform-plugin-framework/types"
-"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -16,6 +12,10 @@ var (
 _ resource.Resource                = &DemeterResource{}
 _ resource.ResourceWithConfigure   = &DemeterResource{}
 _ resource.ResourceWithImportState = &DemeterResource{}
+_ resource.ResourceWithModifyPlan  = &DemeterResource{}
+_ resource.ResourceWithIdentity    = &DemeterResource{}
+_ list.ListResource                = &DemeterResource{}
+_ list.ListResourceWithConfigure   = &DemeterResource{}
 )
 
 // NewDemeterResource is a helper function to simplify the provider implementation.
@@ -23,8 +23,15 @@ func NewDemeterResource() resource.Resource {
 return &DemeterResource{}
 }
 
+// NewDemeterListResource is a helper function to simplify the provider implementation.
+func NewDemeterListResource() list.ListResource {
+return &DemeterResource{}
+}
+
 // DemeterResource is the resource implementation for the Demeter Sentry (Food & Agriculture sector).
-type DemeterResource struct{}
+type DemeterResource struct {
+providerData *ProviderData
+}
 
 // Metadata returns the resource type name.
 func (r *DemeterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,106 +46,52 @@ resp.Schema = GetCommonSentrySchema(
 )
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *DemeterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-// Provider configuration is available in req.ProviderData
-// In a production provider, you would configure an API client here
+// IdentitySchema defines the identity schema for the resource.
+func (r *DemeterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+resp.IdentitySchema = GetSentryIdentitySchema()
 }
 
-// Create creates the resource and sets the initial Terraform state.
-func (r *DemeterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-var plan SentryResourceModel
-diags := req.Plan.Get(ctx, &plan)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
+// ListResourceConfigSchema defines the schema for listing the resource with terraform query.
+func (r *DemeterResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+resp.Schema = GetSentryListSchema("Food & Agriculture")
 }
 
-// Generate a unique ID for the resource
-plan.ID = types.StringValue(fmt.Sprintf("demeter-%s-%d", plan.Name.ValueString(), time.Now().Unix()))
-plan.Sector = types.StringValue("Food & Agriculture")
-plan.Status = types.StringValue("active")
-plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
-
-tflog.Info(ctx, "Creating Demeter sentry", map[string]interface{}{
-"id":   plan.ID.ValueString(),
-"name": plan.Name.ValueString(),
-})
-
-// In a production provider, you would make API calls to create the resource
-// For this skeleton, we just set the state
-
-diags = resp.State.Set(ctx, plan)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
+// Configure adds the provider configuration to the resource.
+func (r *DemeterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+r.providerData = configureProviderData(req, resp)
 }
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DemeterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+createSentry(ctx, "demeter", r.providerData, req, resp)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *DemeterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-var state SentryResourceModel
-diags := req.State.Get(ctx, &state)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
-}
-
-tflog.Info(ctx, "Reading Demeter sentry", map[string]interface{}{
-"id": state.ID.ValueString(),
-})
-
-// In a production provider, you would read the resource from the API
-// For this skeleton, we just use the existing state
-
-diags = resp.State.Set(ctx, &state)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
-}
+readSentry(ctx, "demeter", r.providerData, req, resp)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *DemeterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-var plan SentryResourceModel
-diags := req.Plan.Get(ctx, &plan)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
-}
-
-plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
-
-tflog.Info(ctx, "Updating Demeter sentry", map[string]interface{}{
-"id": plan.ID.ValueString(),
-})
-
-// In a production provider, you would make API calls to update the resource
-
-diags = resp.State.Set(ctx, plan)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
-}
+updateSentry(ctx, "demeter", r.providerData, req, resp)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *DemeterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-var state SentryResourceModel
-diags := req.State.Get(ctx, &state)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
+deleteSentry(ctx, "demeter", r.providerData, req, resp)
 }
 
-tflog.Info(ctx, "Deleting Demeter sentry", map[string]interface{}{
-"id": state.ID.ValueString(),
-})
-
-// In a production provider, you would make API calls to delete the resource
+// ModifyPlan warns before the sentry is disabled and guards against destroying it while protected.
+func (r *DemeterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+modifySentryPlan(ctx, "demeter", r.providerData, req, resp)
 }
 
-// ImportState imports an existing resource into Terraform.
+// ImportState imports an existing resource into Terraform by ID or by resource identity.
 func (r *DemeterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+importSentryState(ctx, "demeter", r.providerData, req, resp)
 }
+
+// List streams the existing sentries matching the list configuration.
+func (r *DemeterResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+listSentries(ctx, "demeter", r.providerData, req, stream)
+}
\ No newline at end of file
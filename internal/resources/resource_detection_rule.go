@@ -0,0 +1,319 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/cywf/sentinel-provider/internal/sigma"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DetectionRuleResource{}
+	_ resource.ResourceWithConfigure   = &DetectionRuleResource{}
+	_ resource.ResourceWithImportState = &DetectionRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &DetectionRuleResource{}
+)
+
+// NewDetectionRuleResource is a helper function to simplify the provider implementation.
+func NewDetectionRuleResource() resource.Resource {
+	return &DetectionRuleResource{}
+}
+
+// DetectionRuleResource manages a custom Sigma detection that runs on a set of sentries.
+type DetectionRuleResource struct {
+	providerData *ProviderData
+}
+
+// DetectionRuleResourceModel describes the detection rule resource data model.
+type DetectionRuleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Sigma       types.String `tfsdk:"sigma"`
+	SentryIDs   types.List   `tfsdk:"sentry_ids"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Title       types.String `tfsdk:"title"`
+	Level       types.String `tfsdk:"level"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (r *DetectionRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_detection_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *DetectionRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom detection written as a Sigma rule and the sentries that run it. " +
+			"The rule is parsed and validated at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the detection rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sigma": schema.StringAttribute{
+				Description: "The Sigma rule as a YAML document, e.g. read with file(). It must have a title, a logsource " +
+					"with a category, product or service, and a detection whose condition only references defined search identifiers.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					sigmaRuleValidator{},
+				},
+			},
+			"sentry_ids": schema.ListAttribute{
+				Description: "The IDs of the sentries that run the detection.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the sentries run the detection. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the Sigma rule.",
+				Computed:    true,
+			},
+			"level": schema.StringAttribute{
+				Description: "The level of the Sigma rule, e.g. high. Null when the rule sets none.",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update to the detection rule (RFC3339 format).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configuration to the resource.
+func (r *DetectionRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// ModifyPlan fills in the title and level of the Sigma rule, so that they are
+// known at plan time.
+func (r *DetectionRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DetectionRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Sigma.IsUnknown() {
+		return
+	}
+
+	rule, err := sigma.Parse(plan.Sigma.ValueString())
+	if err != nil {
+		// Reported by the sigma attribute validator.
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("title"), types.StringValue(rule.Title))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("level"), optionalString(rule.Level))...)
+}
+
+// Create creates the detection rule and sets the initial Terraform state.
+func (r *DetectionRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DetectionRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := plan.APIDetectionRule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating detection rule", map[string]interface{}{
+		"title":      plan.Title.ValueString(),
+		"sentry_ids": rule.SentryIDs,
+	})
+
+	created, err := apiClient.CreateDetectionRule(ctx, rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Detection Rule", "Could not create detection rule "+plan.Title.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPIDetectionRule(ctx, *created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data, removing the
+// detection rule from the state when it was deleted outside of Terraform.
+func (r *DetectionRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DetectionRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading detection rule", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	rule, err := apiClient.GetDetectionRule(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Detection Rule", "Could not read detection rule "+state.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.SetAPIDetectionRule(ctx, *rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the detection rule and sets the updated Terraform state on success.
+func (r *DetectionRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DetectionRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := plan.APIDetectionRule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating detection rule", map[string]interface{}{
+		"id":         plan.ID.ValueString(),
+		"title":      plan.Title.ValueString(),
+		"sentry_ids": rule.SentryIDs,
+	})
+
+	updated, err := apiClient.UpdateDetectionRule(ctx, plan.ID.ValueString(), rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Detection Rule", "Could not update detection rule "+plan.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPIDetectionRule(ctx, *updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the detection rule and removes the Terraform state on success.
+func (r *DetectionRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DetectionRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting detection rule", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	err := apiClient.DeleteDetectionRule(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Detection Rule", "Could not delete detection rule "+state.ID.ValueString()+": "+err.Error())
+	}
+}
+
+// ImportState imports an existing detection rule into Terraform by ID.
+func (r *DetectionRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// APIDetectionRule converts the model into the detection rule sent to the Sentinel API.
+func (m DetectionRuleResourceModel) APIDetectionRule(ctx context.Context) (client.DetectionRule, diag.Diagnostics) {
+	rule := client.DetectionRule{
+		Sigma:   m.Sigma.ValueString(),
+		Enabled: m.Enabled.ValueBool(),
+	}
+	diags := m.SentryIDs.ElementsAs(ctx, &rule.SentryIDs, false)
+	return rule, diags
+}
+
+// SetAPIDetectionRule populates the model from a detection rule returned by
+// the Sentinel API. The title and level are taken from the Sigma rule itself,
+// as they are at plan time.
+func (m *DetectionRuleResourceModel) SetAPIDetectionRule(ctx context.Context, rule client.DetectionRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(rule.ID)
+	m.Sigma = types.StringValue(rule.Sigma)
+	m.Enabled = types.BoolValue(rule.Enabled)
+	m.LastUpdated = types.StringNull()
+	if !rule.UpdatedAt.IsZero() {
+		m.LastUpdated = types.StringValue(rule.UpdatedAt.Format(time.RFC3339))
+	}
+
+	m.Title = types.StringNull()
+	m.Level = types.StringNull()
+	parsed, err := sigma.Parse(rule.Sigma)
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("sigma"), "Invalid Sigma Rule", "The Sigma rule stored in the Sentinel API is not valid: "+err.Error())
+	}
+	if parsed != nil {
+		m.Title = optionalString(parsed.Title)
+		m.Level = optionalString(parsed.Level)
+	}
+
+	var d diag.Diagnostics
+	m.SentryIDs, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(rule.SentryIDs))
+	diags.Append(d...)
+
+	return diags
+}
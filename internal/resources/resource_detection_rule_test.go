@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testSigmaRule = `
+title: SCADA Logon Failures
+level: medium
+logsource:
+  product: windows
+  service: security
+detection:
+  selection:
+    EventID: 4625
+  condition: selection
+`
+
+func TestSigmaRuleValidator(t *testing.T) {
+	validate := func(value string) *validator.StringResponse {
+		resp := &validator.StringResponse{}
+		sigmaRuleValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("sigma"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		return resp
+	}
+
+	if resp := validate(testSigmaRule); resp.Diagnostics.HasError() {
+		t.Errorf("Expected a valid rule, got %v", resp.Diagnostics)
+	}
+
+	// Every problem is reported separately.
+	resp := validate("title: Broken\ndetection:\n  selection:\n    EventID: 4625\n  condition: selection and other\n")
+	if got := resp.Diagnostics.ErrorsCount(); got != 2 {
+		t.Errorf("Expected 2 errors, got %d: %v", got, resp.Diagnostics)
+	}
+}
+
+func TestDetectionRuleResourceModelSetAPIDetectionRule(t *testing.T) {
+	var model DetectionRuleResourceModel
+	diags := model.SetAPIDetectionRule(context.Background(), client.DetectionRule{
+		ID:        "dr-123",
+		Sigma:     testSigmaRule,
+		SentryIDs: []string{"ra-grid-1"},
+		Enabled:   true,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.Title.ValueString() != "SCADA Logon Failures" || model.Level.ValueString() != "medium" {
+		t.Errorf("Expected title and level from the Sigma rule, got %s and %s", model.Title, model.Level)
+	}
+	if len(model.SentryIDs.Elements()) != 1 || !model.LastUpdated.IsNull() {
+		t.Errorf("Unexpected model: %+v", model)
+	}
+}
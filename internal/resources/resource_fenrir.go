@@ -2,13 +2,9 @@ package resources
 
 import (
 "context"
-"fmt"
-"time"
 
-"github.com/hashicorp/terraform-plugin-framework/path"
+"github.com/hashicorp/terraform-plugin-framework/list"
 "github.com/hashicorp/terraform-plugin-framework/resource"
-"github.com/hashicorp/terraform-plugin-framework/types"
-"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -16,6 +12,10 @@ var (
 _ resource.Resource                = &FenrirResource{}
 _ resource.ResourceWithConfigure   = &FenrirResource{}
 _ resource.ResourceWithImportState = &FenrirResource{}
+_ resource.ResourceWithModifyPlan  = &FenrirResource{}
+_ resource.ResourceWithIdentity    = &FenrirResource{}
+_ list.ListResource                = &FenrirResource{}
+_ list.ListResourceWithConfigure   = &FenrirResource{}
 )
 
 // NewFenrirResource is a helper function to simplify the provider implementation.
@@ -23,8 +23,15 @@ func NewFenrirResource() resource.Resource {
 return &FenrirResource{}
 }
 
+// NewFenrirListResource is a helper function to simplify the provider implementation.
+func NewFenrirListResource() list.ListResource {
+return &FenrirResource{}
+}
+
 // FenrirResource is the resource implementation for the Fenrir Sentry (Information Technology sector).
-type FenrirResource struct{}
+type FenrirResource struct {
+providerData *ProviderData
+}
 
 // Metadata returns the resource type name.
 func (r *FenrirResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,106 +46,52 @@ resp.Schema = GetCommonSentrySchema(
 )
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *FenrirResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-// Provider configuration is available in req.ProviderData
-// In a production provider, you would configure an API client here
+// IdentitySchema defines the identity schema for the resource.
+func (r *FenrirResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+resp.IdentitySchema = GetSentryIdentitySchema()
 }
 
-// Create creates the resource and sets the initial Terraform state.
-func (r *FenrirResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-var plan SentryResourceModel
-diags := req.Plan.Get(ctx, &plan)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
+// ListResourceConfigSchema defines the schema for listing the resource with terraform query.
+func (r *FenrirResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+resp.Schema = GetSentryListSchema("Information Technology")
 }
 
-// Generate a unique ID for the resource
-plan.ID = types.StringValue(fmt.Sprintf("fenrir-%s-%d", plan.Name.ValueString(), time.Now().Unix()))
-plan.Sector = types.StringValue("Information Technology")
-plan.Status = types.StringValue("active")
-plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
-
-tflog.Info(ctx, "Creating Fenrir sentry", map[string]interface{}{
-"id":   plan.ID.ValueString(),
-"name": plan.Name.ValueString(),
-})
-
-// In a production provider, you would make API calls to create the resource
-// For this skeleton, we just set the state
-
-diags = resp.State.Set(ctx, plan)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
+// Configure adds the provider configuration to the resource.
+func (r *FenrirResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+r.providerData = configureProviderData(req, resp)
 }
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *FenrirResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+createSentry(ctx, "fenrir", r.providerData, req, resp)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *FenrirResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-var state SentryResourceModel
-diags := req.State.Get(ctx, &state)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
-}
-
-tflog.Info(ctx, "Reading Fenrir sentry", map[string]interface{}{
-"id": state.ID.ValueString(),
-})
-
-// In a production provider, you would read the resource from the API
-// For this skeleton, we just use the existing state
-
-diags = resp.State.Set(ctx, &state)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
-}
+readSentry(ctx, "fenrir", r.providerData, req, resp)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *FenrirResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-var plan SentryResourceModel
-diags := req.Plan.Get(ctx, &plan)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
-}
-
-plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
-
-tflog.Info(ctx, "Updating Fenrir sentry", map[string]interface{}{
-"id": plan.ID.ValueString(),
-})
-
-// In a production provider, you would make API calls to update the resource
-
-diags = resp.State.Set(ctx, plan)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
-}
+updateSentry(ctx, "fenrir", r.providerData, req, resp)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *FenrirResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-var state SentryResourceModel
-diags := req.State.Get(ctx, &state)
-resp.Diagnostics.Append(diags...)
-if resp.Diagnostics.HasError() {
-return
+deleteSentry(ctx, "fenrir", r.providerData, req, resp)
 }
 
-tflog.Info(ctx, "Deleting Fenrir sentry", map[string]interface{}{
-"id": state.ID.ValueString(),
-})
-
-// In a production provider, you would make API calls to delete the resource
+// ModifyPlan warns before the sentry is disabled and guards against destroying it while protected.
+func (r *FenrirResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+modifySentryPlan(ctx, "fenrir", r.providerData, req, resp)
 }
 
-// ImportState imports an existing resource into Terraform.
+// ImportState imports an existing resource into Terraform by ID or by resource identity.
 func (r *FenrirResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+importSentryState(ctx, "fenrir", r.providerData, req, resp)
 }
+
+// List streams the existing sentries matching the list configuration.
+func (r *FenrirResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+listSentries(ctx, "fenrir", r.providerData, req, stream)
+}
\ No newline at end of file
@@ -0,0 +1,554 @@
+package resources
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &NotificationChannelResource{}
+	_ resource.ResourceWithConfigure        = &NotificationChannelResource{}
+	_ resource.ResourceWithImportState      = &NotificationChannelResource{}
+	_ resource.ResourceWithModifyPlan       = &NotificationChannelResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationChannelResource{}
+)
+
+// httpsURLRegexp matches the https URLs notifications may be delivered to.
+var httpsURLRegexp = regexp.MustCompile(`^https://[^\s/]+\S*$`)
+
+// pagerDutyRoutingKeyRegexp matches the 32 character integration keys of PagerDuty Events API v2.
+var pagerDutyRoutingKeyRegexp = regexp.MustCompile(`^[a-z0-9]{32}$`)
+
+// NewNotificationChannelResource is a helper function to simplify the provider implementation.
+func NewNotificationChannelResource() resource.Resource {
+	return &NotificationChannelResource{}
+}
+
+// NotificationChannelResource manages a destination alerts are delivered to.
+type NotificationChannelResource struct {
+	providerData *ProviderData
+}
+
+// NotificationChannelResourceModel describes the notification channel resource data model.
+type NotificationChannelResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Email     types.Object `tfsdk:"email"`
+	Webhook   types.Object `tfsdk:"webhook"`
+	Slack     types.Object `tfsdk:"slack"`
+	PagerDuty types.Object `tfsdk:"pagerduty"`
+}
+
+// EmailChannelModel describes the settings of an email channel.
+type EmailChannelModel struct {
+	Addresses types.List `tfsdk:"addresses"`
+}
+
+// AttributeTypes returns the attribute types of the email object.
+func (m EmailChannelModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"addresses": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// WebhookChannelModel describes the settings of a generic webhook channel.
+type WebhookChannelModel struct {
+	URL           types.String `tfsdk:"url"`
+	SigningSecret types.String `tfsdk:"signing_secret"`
+	Headers       types.Map    `tfsdk:"headers"`
+}
+
+// AttributeTypes returns the attribute types of the webhook object.
+func (m WebhookChannelModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url":            types.StringType,
+		"signing_secret": types.StringType,
+		"headers":        types.MapType{ElemType: types.StringType},
+	}
+}
+
+// SlackChannelModel describes the settings of a Slack channel.
+type SlackChannelModel struct {
+	WebhookURL types.String `tfsdk:"webhook_url"`
+	Channel    types.String `tfsdk:"channel"`
+}
+
+// AttributeTypes returns the attribute types of the slack object.
+func (m SlackChannelModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"webhook_url": types.StringType,
+		"channel":     types.StringType,
+	}
+}
+
+// PagerDutyChannelModel describes the settings of a PagerDuty channel.
+type PagerDutyChannelModel struct {
+	RoutingKey types.String `tfsdk:"routing_key"`
+}
+
+// AttributeTypes returns the attribute types of the pagerduty object.
+func (m PagerDutyChannelModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"routing_key": types.StringType,
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *NotificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+// Schema defines the schema for the resource.
+func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification channel that alerts are delivered to: email, a generic signed webhook, " +
+			"a Slack incoming webhook or PagerDuty Events API v2. Exactly one of email, webhook, slack and pagerduty must be set. " +
+			"Sentries reference channels through notification_channel_ids.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the notification channel.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the notification channel.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the channel, derived from the settings that are set: email, webhook, slack or pagerduty.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether alerts are delivered through the channel. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"email": schema.SingleNestedAttribute{
+				Description: "Delivers alerts by email.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"addresses": schema.ListAttribute{
+						Description: "The email addresses alerts are sent to.",
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(emailRegexp, "must be a valid email address"),
+							),
+						},
+					},
+				},
+			},
+			"webhook": schema.SingleNestedAttribute{
+				Description: "Delivers alerts as JSON to a generic webhook. Each request carries an " +
+					"X-Sentinel-Signature header with the HMAC-SHA256 of the body, keyed with signing_secret.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "The https URL alerts are posted to.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(httpsURLRegexp, "must be an https URL"),
+						},
+					},
+					"signing_secret": schema.StringAttribute{
+						Description: "The secret requests are signed with. The Sentinel API never returns it, " +
+							"so changes made outside of Terraform are not detected.",
+						Required:  true,
+						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(16),
+						},
+					},
+					"headers": schema.MapAttribute{
+						Description: "Additional HTTP headers sent with every request.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"slack": schema.SingleNestedAttribute{
+				Description: "Delivers alerts to a Slack incoming webhook.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"webhook_url": schema.StringAttribute{
+						Description: "The URL of the Slack incoming webhook. The Sentinel API never returns it, " +
+							"so changes made outside of Terraform are not detected.",
+						Required:  true,
+						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https://hooks\.slack\.com/`), "must be a Slack incoming webhook URL"),
+						},
+					},
+					"channel": schema.StringAttribute{
+						Description: "Overrides the Slack channel the webhook posts to, e.g. #soc-alerts.",
+						Optional:    true,
+					},
+				},
+			},
+			"pagerduty": schema.SingleNestedAttribute{
+				Description: "Triggers PagerDuty incidents through the Events API v2.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"routing_key": schema.StringAttribute{
+						Description: "The integration key of the PagerDuty service. The Sentinel API never returns it, " +
+							"so changes made outside of Terraform are not detected.",
+						Required:  true,
+						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(pagerDutyRoutingKeyRegexp, "must be a 32 character PagerDuty integration key"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators requires exactly one kind of channel settings.
+func (r *NotificationChannelResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("email"),
+			path.MatchRoot("webhook"),
+			path.MatchRoot("slack"),
+			path.MatchRoot("pagerduty"),
+		),
+	}
+}
+
+// Configure adds the provider configuration to the resource.
+func (r *NotificationChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// ModifyPlan derives the channel type from the settings that are set.
+func (r *NotificationChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan NotificationChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), plan.channelType())...)
+}
+
+// Create creates the notification channel and sets the initial Terraform state.
+func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, diags := plan.APINotificationChannel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating notification channel", map[string]interface{}{
+		"name": channel.Name,
+		"type": channel.Type,
+	})
+
+	created, err := apiClient.CreateNotificationChannel(ctx, channel)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Notification Channel", "Could not create notification channel "+channel.Name+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPINotificationChannel(ctx, *created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data, removing the
+// notification channel from the state when it was deleted outside of Terraform.
+func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NotificationChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading notification channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	channel, err := apiClient.GetNotificationChannel(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Notification Channel", "Could not read notification channel "+state.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.SetAPINotificationChannel(ctx, *channel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the notification channel and sets the updated Terraform state on success.
+func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, diags := plan.APINotificationChannel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating notification channel", map[string]interface{}{
+		"id":   plan.ID.ValueString(),
+		"name": channel.Name,
+		"type": channel.Type,
+	})
+
+	updated, err := apiClient.UpdateNotificationChannel(ctx, plan.ID.ValueString(), channel)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Notification Channel", "Could not update notification channel "+plan.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPINotificationChannel(ctx, *updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the notification channel and removes the Terraform state on success.
+func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting notification channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	err := apiClient.DeleteNotificationChannel(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Notification Channel", "Could not delete notification channel "+state.ID.ValueString()+": "+err.Error())
+	}
+}
+
+// ImportState imports an existing notification channel into Terraform by ID.
+// Secrets are not returned by the Sentinel API and are sent again on the next apply.
+func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// channelType returns the type matching the settings that are set, or unknown
+// when none is known yet.
+func (m NotificationChannelResourceModel) channelType() types.String {
+	switch {
+	case !m.Email.IsNull() && !m.Email.IsUnknown():
+		return types.StringValue(client.NotificationChannelEmail)
+	case !m.Webhook.IsNull() && !m.Webhook.IsUnknown():
+		return types.StringValue(client.NotificationChannelWebhook)
+	case !m.Slack.IsNull() && !m.Slack.IsUnknown():
+		return types.StringValue(client.NotificationChannelSlack)
+	case !m.PagerDuty.IsNull() && !m.PagerDuty.IsUnknown():
+		return types.StringValue(client.NotificationChannelPagerDuty)
+	}
+	return types.StringUnknown()
+}
+
+// APINotificationChannel converts the model into the notification channel sent to the Sentinel API.
+func (m NotificationChannelResourceModel) APINotificationChannel(ctx context.Context) (client.NotificationChannel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	channel := client.NotificationChannel{
+		Name:    m.Name.ValueString(),
+		Type:    m.channelType().ValueString(),
+		Enabled: m.Enabled.ValueBool(),
+	}
+
+	switch channel.Type {
+	case client.NotificationChannelEmail:
+		var email EmailChannelModel
+		diags.Append(m.Email.As(ctx, &email, basetypes.ObjectAsOptions{})...)
+		channel.Email = &client.EmailChannelSettings{}
+		diags.Append(email.Addresses.ElementsAs(ctx, &channel.Email.Addresses, false)...)
+	case client.NotificationChannelWebhook:
+		var webhook WebhookChannelModel
+		diags.Append(m.Webhook.As(ctx, &webhook, basetypes.ObjectAsOptions{})...)
+		channel.Webhook = &client.WebhookChannelSettings{
+			URL:           webhook.URL.ValueString(),
+			SigningSecret: webhook.SigningSecret.ValueString(),
+		}
+		if !webhook.Headers.IsNull() {
+			diags.Append(webhook.Headers.ElementsAs(ctx, &channel.Webhook.Headers, false)...)
+		}
+	case client.NotificationChannelSlack:
+		var slack SlackChannelModel
+		diags.Append(m.Slack.As(ctx, &slack, basetypes.ObjectAsOptions{})...)
+		channel.Slack = &client.SlackChannelSettings{
+			WebhookURL: slack.WebhookURL.ValueString(),
+			Channel:    slack.Channel.ValueString(),
+		}
+	case client.NotificationChannelPagerDuty:
+		var pagerDuty PagerDutyChannelModel
+		diags.Append(m.PagerDuty.As(ctx, &pagerDuty, basetypes.ObjectAsOptions{})...)
+		channel.PagerDuty = &client.PagerDutyChannelSettings{
+			RoutingKey: pagerDuty.RoutingKey.ValueString(),
+		}
+	}
+
+	return channel, diags
+}
+
+// SetAPINotificationChannel populates the model from a notification channel
+// returned by the Sentinel API. The API never returns secrets, so the secrets
+// already held by the model are kept.
+func (m *NotificationChannelResourceModel) SetAPINotificationChannel(ctx context.Context, channel client.NotificationChannel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.ID = types.StringValue(channel.ID)
+	m.Name = types.StringValue(channel.Name)
+	m.Type = types.StringValue(channel.Type)
+	m.Enabled = types.BoolValue(channel.Enabled)
+
+	email := types.ObjectNull(EmailChannelModel{}.AttributeTypes())
+	if channel.Email != nil {
+		model := EmailChannelModel{}
+		model.Addresses, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(channel.Email.Addresses))
+		diags.Append(d...)
+		email, d = types.ObjectValueFrom(ctx, model.AttributeTypes(), model)
+		diags.Append(d...)
+	}
+
+	webhook := types.ObjectNull(WebhookChannelModel{}.AttributeTypes())
+	if channel.Webhook != nil {
+		var previous WebhookChannelModel
+		if !m.Webhook.IsNull() && !m.Webhook.IsUnknown() {
+			diags.Append(m.Webhook.As(ctx, &previous, basetypes.ObjectAsOptions{})...)
+		}
+
+		model := WebhookChannelModel{
+			URL:           types.StringValue(channel.Webhook.URL),
+			SigningSecret: previous.SigningSecret,
+			Headers:       types.MapNull(types.StringType),
+		}
+		if model.SigningSecret.IsUnknown() {
+			model.SigningSecret = types.StringNull()
+		}
+		if len(channel.Webhook.Headers) > 0 {
+			model.Headers, d = types.MapValueFrom(ctx, types.StringType, channel.Webhook.Headers)
+			diags.Append(d...)
+		}
+		webhook, d = types.ObjectValueFrom(ctx, model.AttributeTypes(), model)
+		diags.Append(d...)
+	}
+
+	slack := types.ObjectNull(SlackChannelModel{}.AttributeTypes())
+	if channel.Slack != nil {
+		var previous SlackChannelModel
+		if !m.Slack.IsNull() && !m.Slack.IsUnknown() {
+			diags.Append(m.Slack.As(ctx, &previous, basetypes.ObjectAsOptions{})...)
+		}
+
+		model := SlackChannelModel{
+			WebhookURL: previous.WebhookURL,
+			Channel:    types.StringNull(),
+		}
+		if model.WebhookURL.IsUnknown() {
+			model.WebhookURL = types.StringNull()
+		}
+		if channel.Slack.Channel != "" {
+			model.Channel = types.StringValue(channel.Slack.Channel)
+		}
+		slack, d = types.ObjectValueFrom(ctx, model.AttributeTypes(), model)
+		diags.Append(d...)
+	}
+
+	pagerDuty := types.ObjectNull(PagerDutyChannelModel{}.AttributeTypes())
+	if channel.PagerDuty != nil {
+		var previous PagerDutyChannelModel
+		if !m.PagerDuty.IsNull() && !m.PagerDuty.IsUnknown() {
+			diags.Append(m.PagerDuty.As(ctx, &previous, basetypes.ObjectAsOptions{})...)
+		}
+
+		model := PagerDutyChannelModel{RoutingKey: previous.RoutingKey}
+		if model.RoutingKey.IsUnknown() {
+			model.RoutingKey = types.StringNull()
+		}
+		pagerDuty, d = types.ObjectValueFrom(ctx, model.AttributeTypes(), model)
+		diags.Append(d...)
+	}
+
+	m.Email = email
+	m.Webhook = webhook
+	m.Slack = slack
+	m.PagerDuty = pagerDuty
+
+	return diags
+}
@@ -0,0 +1,111 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestNotificationChannelResourceModelAPINotificationChannel(t *testing.T) {
+	ctx := context.Background()
+
+	model := NotificationChannelResourceModel{
+		Name:    types.StringValue("soc-webhook"),
+		Enabled: types.BoolValue(true),
+		Email:   types.ObjectNull(EmailChannelModel{}.AttributeTypes()),
+		Webhook: types.ObjectValueMust(WebhookChannelModel{}.AttributeTypes(), map[string]attr.Value{
+			"url":            types.StringValue("https://soc.example.com/hooks/sentinel"),
+			"signing_secret": types.StringValue("0123456789abcdef"),
+			"headers": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"X-Team": types.StringValue("soc"),
+			}),
+		}),
+		Slack:     types.ObjectNull(SlackChannelModel{}.AttributeTypes()),
+		PagerDuty: types.ObjectNull(PagerDutyChannelModel{}.AttributeTypes()),
+	}
+
+	channel, diags := model.APINotificationChannel(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if channel.Type != client.NotificationChannelWebhook {
+		t.Errorf("Expected type %q, got %q", client.NotificationChannelWebhook, channel.Type)
+	}
+	if channel.Webhook == nil {
+		t.Fatal("Expected webhook settings")
+	}
+	if channel.Webhook.SigningSecret != "0123456789abcdef" {
+		t.Errorf("Expected the signing secret to be sent, got %q", channel.Webhook.SigningSecret)
+	}
+	if channel.Webhook.Headers["X-Team"] != "soc" {
+		t.Errorf("Expected header X-Team to be soc, got %v", channel.Webhook.Headers)
+	}
+	if channel.Email != nil || channel.Slack != nil || channel.PagerDuty != nil {
+		t.Errorf("Expected only webhook settings, got %+v", channel)
+	}
+}
+
+func TestNotificationChannelResourceModelSetAPINotificationChannel(t *testing.T) {
+	ctx := context.Background()
+
+	// The API never returns the routing key, so the one in the state is kept.
+	model := NotificationChannelResourceModel{
+		PagerDuty: types.ObjectValueMust(PagerDutyChannelModel{}.AttributeTypes(), map[string]attr.Value{
+			"routing_key": types.StringValue("0123456789abcdef0123456789abcdef"),
+		}),
+	}
+	diags := model.SetAPINotificationChannel(ctx, client.NotificationChannel{
+		ID:        "nc-123",
+		Name:      "oncall",
+		Type:      client.NotificationChannelPagerDuty,
+		Enabled:   false,
+		PagerDuty: &client.PagerDutyChannelSettings{},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.ID.ValueString() != "nc-123" || model.Type.ValueString() != client.NotificationChannelPagerDuty || model.Enabled.ValueBool() {
+		t.Errorf("Unexpected channel attributes: %+v", model)
+	}
+	if !model.Email.IsNull() || !model.Webhook.IsNull() || !model.Slack.IsNull() {
+		t.Error("Expected the settings of other channel types to be null")
+	}
+
+	var pagerDuty PagerDutyChannelModel
+	diags = model.PagerDuty.As(ctx, &pagerDuty, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if pagerDuty.RoutingKey.ValueString() != "0123456789abcdef0123456789abcdef" {
+		t.Errorf("Expected the routing key to be kept, got %q", pagerDuty.RoutingKey.ValueString())
+	}
+
+	// An imported channel has no secrets in the state yet.
+	var imported NotificationChannelResourceModel
+	diags = imported.SetAPINotificationChannel(ctx, client.NotificationChannel{
+		ID:    "nc-456",
+		Type:  client.NotificationChannelSlack,
+		Slack: &client.SlackChannelSettings{Channel: "#soc-alerts"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var slack SlackChannelModel
+	diags = imported.Slack.As(ctx, &slack, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !slack.WebhookURL.IsNull() {
+		t.Errorf("Expected a null webhook URL, got %s", slack.WebhookURL)
+	}
+	if slack.Channel.ValueString() != "#soc-alerts" {
+		t.Errorf("Expected channel #soc-alerts, got %s", slack.Channel)
+	}
+}
@@ -0,0 +1,682 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &ResponsePlaybookResource{}
+	_ resource.ResourceWithConfigure        = &ResponsePlaybookResource{}
+	_ resource.ResourceWithImportState      = &ResponsePlaybookResource{}
+	_ resource.ResourceWithConfigValidators = &ResponsePlaybookResource{}
+	_ resource.ResourceWithValidateConfig   = &ResponsePlaybookResource{}
+)
+
+// Defaults applied to playbook steps when the attributes are omitted.
+const (
+	DefaultPlaybookStepRunIf   = "success"
+	DefaultPlaybookStepTimeout = "5m"
+)
+
+// PlaybookActions lists the accepted values for steps.action.
+var PlaybookActions = []string{"isolate_host", "block_ip", "disable_account", "open_ticket", "notify"}
+
+// PlaybookRunIfValues lists the accepted values for steps.run_if.
+var PlaybookRunIfValues = []string{"success", "failure", "always"}
+
+// NewResponsePlaybookResource is a helper function to simplify the provider implementation.
+func NewResponsePlaybookResource() resource.Resource {
+	return &ResponsePlaybookResource{}
+}
+
+// ResponsePlaybookResource manages automated response actions run when a sentry detects something.
+type ResponsePlaybookResource struct {
+	providerData *ProviderData
+}
+
+// ResponsePlaybookResourceModel describes the response playbook resource data model.
+type ResponsePlaybookResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	AlertRuleIDs types.List   `tfsdk:"alert_rule_ids"`
+	SentryIDs    types.List   `tfsdk:"sentry_ids"`
+	Steps        types.List   `tfsdk:"steps"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+// PlaybookStepModel describes a step of a response playbook.
+type PlaybookStepModel struct {
+	ID               types.String `tfsdk:"id"`
+	Action           types.String `tfsdk:"action"`
+	Parameters       types.Map    `tfsdk:"parameters"`
+	DependsOn        types.List   `tfsdk:"depends_on"`
+	RunIf            types.String `tfsdk:"run_if"`
+	MinSeverity      types.String `tfsdk:"min_severity"`
+	RequiresApproval types.Bool   `tfsdk:"requires_approval"`
+	Approvers        types.List   `tfsdk:"approvers"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+// AttributeTypes returns the attribute types of a step object.
+func (m PlaybookStepModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"action":            types.StringType,
+		"parameters":        types.MapType{ElemType: types.StringType},
+		"depends_on":        types.ListType{ElemType: types.StringType},
+		"run_if":            types.StringType,
+		"min_severity":      types.StringType,
+		"requires_approval": types.BoolType,
+		"approvers":         types.ListType{ElemType: types.StringType},
+		"timeout":           types.StringType,
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *ResponsePlaybookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_response_playbook"
+}
+
+// Schema defines the schema for the resource.
+func (r *ResponsePlaybookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a response playbook: automated steps, such as isolating a host or opening a ticket, run when " +
+			"one of its alert rules fires or one of its sentries detects an incident. Steps and their depends_on must " +
+			"form a directed acyclic graph, which is validated at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the response playbook.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the response playbook.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the response playbook.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the playbook runs when triggered. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"alert_rule_ids": schema.ListAttribute{
+				Description: "The IDs of the sentinel_alert_rule resources that trigger the playbook when they fire.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"sentry_ids": schema.ListAttribute{
+				Description: "The IDs of the sentries that trigger the playbook for every incident they detect.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"steps": schema.ListNestedAttribute{
+				Description: "The steps of the playbook. A step starts once all steps it depends on have finished; " +
+					"steps that are ready at the same time start in list order.",
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The identifier of the step within the playbook, e.g. isolate.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(configKeyRegexp, "must be lower case with words separated by underscores"),
+							},
+						},
+						"action": schema.StringAttribute{
+							Description: "The action of the step: isolate_host, block_ip, disable_account, open_ticket or notify.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(PlaybookActions...),
+							},
+						},
+						"parameters": schema.MapAttribute{
+							Description: "Parameters of the action, e.g. the ticket queue or the notification channel ID.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Map{
+								mapvalidator.SizeAtLeast(1),
+							},
+						},
+						"depends_on": schema.ListAttribute{
+							Description: "The IDs of the steps that must finish before this step starts.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+							},
+						},
+						"run_if": schema.StringAttribute{
+							Description: "Which outcome of the steps in depends_on runs this step: success, failure or always. " +
+								"Defaults to success.",
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(DefaultPlaybookStepRunIf),
+							Validators: []validator.String{
+								stringvalidator.OneOf(PlaybookRunIfValues...),
+							},
+						},
+						"min_severity": schema.StringAttribute{
+							Description: "Only run the step for incidents of at least this severity: low, medium, high or critical.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(SeverityLevels...),
+							},
+						},
+						"requires_approval": schema.BoolAttribute{
+							Description: "Whether a person must approve the step before it runs. Defaults to false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"approvers": schema.ListAttribute{
+							Description: "Email addresses of the people who may approve the step. When omitted, anyone " +
+								"with the respond scope may approve. Requires requires_approval.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(
+									stringvalidator.RegexMatches(emailRegexp, "must be a valid email address"),
+								),
+							},
+						},
+						"timeout": schema.StringAttribute{
+							Description: "How long the step may run, including the wait for approval, before it fails, " +
+								"e.g. 30s or 2h. Defaults to 5m.",
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(DefaultPlaybookStepTimeout),
+							Validators: []validator.String{
+								durationValidator{min: 10 * time.Second, max: 24 * time.Hour},
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update to the response playbook (RFC3339 format).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires the playbook to be linked to alert rules or sentries.
+func (r *ResponsePlaybookResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("alert_rule_ids"),
+			path.MatchRoot("sentry_ids"),
+		),
+	}
+}
+
+// ValidateConfig checks that the steps form a directed acyclic graph and that
+// approvers are only set on steps that require approval.
+func (r *ResponsePlaybookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var steps types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("steps"), &steps)...)
+	if resp.Diagnostics.HasError() || steps.IsNull() || steps.IsUnknown() {
+		return
+	}
+
+	models := make([]PlaybookStepModel, 0, len(steps.Elements()))
+	for _, element := range steps.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			return
+		}
+
+		var model PlaybookStepModel
+		resp.Diagnostics.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		models = append(models, model)
+	}
+
+	for i, step := range models {
+		if !step.Approvers.IsNull() && !step.RequiresApproval.IsUnknown() && !step.RequiresApproval.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("steps").AtListIndex(i).AtName("approvers"),
+				"Approvers Without Approval",
+				"approvers can only be set on steps with requires_approval = true.",
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(validatePlaybookGraph(ctx, models)...)
+}
+
+// Configure adds the provider configuration to the resource.
+func (r *ResponsePlaybookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// Create creates the response playbook and sets the initial Terraform state.
+func (r *ResponsePlaybookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResponsePlaybookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	playbook, diags := plan.APIResponsePlaybook(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating response playbook", map[string]interface{}{
+		"name":           playbook.Name,
+		"alert_rule_ids": playbook.AlertRuleIDs,
+		"sentry_ids":     playbook.SentryIDs,
+		"steps":          len(playbook.Steps),
+	})
+
+	created, err := apiClient.CreateResponsePlaybook(ctx, playbook)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Response Playbook", "Could not create response playbook "+playbook.Name+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPIResponsePlaybook(ctx, *created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data, removing the
+// response playbook from the state when it was deleted outside of Terraform.
+func (r *ResponsePlaybookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResponsePlaybookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading response playbook", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	playbook, err := apiClient.GetResponsePlaybook(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Response Playbook", "Could not read response playbook "+state.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.SetAPIResponsePlaybook(ctx, *playbook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the response playbook and sets the updated Terraform state on success.
+func (r *ResponsePlaybookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ResponsePlaybookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	playbook, diags := plan.APIResponsePlaybook(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating response playbook", map[string]interface{}{
+		"id":             plan.ID.ValueString(),
+		"name":           playbook.Name,
+		"alert_rule_ids": playbook.AlertRuleIDs,
+		"sentry_ids":     playbook.SentryIDs,
+		"steps":          len(playbook.Steps),
+	})
+
+	updated, err := apiClient.UpdateResponsePlaybook(ctx, plan.ID.ValueString(), playbook)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Response Playbook", "Could not update response playbook "+plan.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPIResponsePlaybook(ctx, *updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the response playbook and removes the Terraform state on success.
+func (r *ResponsePlaybookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResponsePlaybookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting response playbook", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	err := apiClient.DeleteResponsePlaybook(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Response Playbook", "Could not delete response playbook "+state.ID.ValueString()+": "+err.Error())
+	}
+}
+
+// ImportState imports an existing response playbook into Terraform by ID.
+func (r *ResponsePlaybookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validatePlaybookGraph checks that step IDs are unique and that depends_on
+// only references other steps of the playbook without forming a cycle.
+// Validation is skipped while step IDs or dependencies are unknown.
+func validatePlaybookGraph(ctx context.Context, steps []PlaybookStepModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := make([]string, len(steps))
+	index := make(map[string]int, len(steps))
+	for i, step := range steps {
+		if step.ID.IsUnknown() {
+			return diags
+		}
+		ids[i] = step.ID.ValueString()
+		if j, ok := index[ids[i]]; ok {
+			diags.AddAttributeError(
+				path.Root("steps").AtListIndex(i).AtName("id"),
+				"Duplicate Playbook Step",
+				fmt.Sprintf("Step ID %q is already used by step %d.", ids[i], j+1),
+			)
+			continue
+		}
+		index[ids[i]] = i
+	}
+
+	edges := make([][]int, len(steps))
+	for i, step := range steps {
+		if step.DependsOn.IsNull() {
+			continue
+		}
+		if step.DependsOn.IsUnknown() {
+			return diags
+		}
+
+		var dependsOn []types.String
+		diags.Append(step.DependsOn.ElementsAs(ctx, &dependsOn, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, dependency := range dependsOn {
+			if dependency.IsUnknown() {
+				return diags
+			}
+			j, ok := index[dependency.ValueString()]
+			switch {
+			case dependency.ValueString() == ids[i]:
+				diags.AddAttributeError(
+					path.Root("steps").AtListIndex(i).AtName("depends_on"),
+					"Playbook Step Depends On Itself",
+					fmt.Sprintf("Step %q cannot depend on itself.", ids[i]),
+				)
+			case !ok:
+				diags.AddAttributeError(
+					path.Root("steps").AtListIndex(i).AtName("depends_on"),
+					"Unknown Playbook Step",
+					fmt.Sprintf("Step %q depends on %q, which is not a step of this playbook.", ids[i], dependency.ValueString()),
+				)
+			default:
+				edges[i] = append(edges[i], j)
+			}
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if cycle := findCycle(edges); cycle != nil {
+		names := make([]string, len(cycle))
+		for k, i := range cycle {
+			names[k] = ids[i]
+		}
+		diags.AddAttributeError(
+			path.Root("steps"),
+			"Playbook Step Cycle",
+			"The steps must form a directed acyclic graph, but depends_on contains the cycle "+strings.Join(names, " -> ")+".",
+		)
+	}
+
+	return diags
+}
+
+// findCycle returns the nodes of a cycle in the directed graph given as
+// adjacency lists, starting and ending with the same node, or nil when the
+// graph is acyclic.
+func findCycle(edges [][]int) []int {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(edges))
+	var stack []int
+
+	var visit func(node int) []int
+	visit = func(node int) []int {
+		state[node] = visiting
+		stack = append(stack, node)
+		for _, next := range edges[node] {
+			switch state[next] {
+			case visiting:
+				for k, n := range stack {
+					if n == next {
+						return append(append([]int{}, stack[k:]...), next)
+					}
+				}
+			case unvisited:
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[node] = visited
+		return nil
+	}
+
+	for node := range edges {
+		if state[node] == unvisited {
+			if cycle := visit(node); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// APIResponsePlaybook converts the model into the response playbook sent to the Sentinel API.
+func (m ResponsePlaybookResourceModel) APIResponsePlaybook(ctx context.Context) (client.ResponsePlaybook, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	playbook := client.ResponsePlaybook{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Enabled:     m.Enabled.ValueBool(),
+	}
+	if !m.AlertRuleIDs.IsNull() {
+		diags.Append(m.AlertRuleIDs.ElementsAs(ctx, &playbook.AlertRuleIDs, false)...)
+	}
+	if !m.SentryIDs.IsNull() {
+		diags.Append(m.SentryIDs.ElementsAs(ctx, &playbook.SentryIDs, false)...)
+	}
+
+	var steps []PlaybookStepModel
+	diags.Append(m.Steps.ElementsAs(ctx, &steps, false)...)
+	if diags.HasError() {
+		return playbook, diags
+	}
+
+	for _, step := range steps {
+		apiStep := client.PlaybookStep{
+			ID:               step.ID.ValueString(),
+			Action:           step.Action.ValueString(),
+			RunIf:            step.RunIf.ValueString(),
+			MinSeverity:      step.MinSeverity.ValueString(),
+			RequiresApproval: step.RequiresApproval.ValueBool(),
+			Timeout:          step.Timeout.ValueString(),
+		}
+		if !step.Parameters.IsNull() {
+			diags.Append(step.Parameters.ElementsAs(ctx, &apiStep.Parameters, false)...)
+		}
+		if !step.DependsOn.IsNull() {
+			diags.Append(step.DependsOn.ElementsAs(ctx, &apiStep.DependsOn, false)...)
+		}
+		if !step.Approvers.IsNull() {
+			diags.Append(step.Approvers.ElementsAs(ctx, &apiStep.Approvers, false)...)
+		}
+		playbook.Steps = append(playbook.Steps, apiStep)
+	}
+
+	return playbook, diags
+}
+
+// SetAPIResponsePlaybook populates the model from a response playbook returned by the Sentinel API.
+func (m *ResponsePlaybookResourceModel) SetAPIResponsePlaybook(ctx context.Context, playbook client.ResponsePlaybook) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	// Step timeouts are kept as planned when the API returns them spelled differently.
+	currentTimeouts := make(map[string]types.String)
+	if !m.Steps.IsNull() && !m.Steps.IsUnknown() {
+		var current []PlaybookStepModel
+		diags.Append(m.Steps.ElementsAs(ctx, &current, false)...)
+		for _, step := range current {
+			currentTimeouts[step.ID.ValueString()] = step.Timeout
+		}
+	}
+
+	m.ID = types.StringValue(playbook.ID)
+	m.Name = types.StringValue(playbook.Name)
+	m.Description = optionalString(playbook.Description)
+	m.Enabled = types.BoolValue(playbook.Enabled)
+	m.AlertRuleIDs, d = optionalStringList(ctx, playbook.AlertRuleIDs)
+	diags.Append(d...)
+	m.SentryIDs, d = optionalStringList(ctx, playbook.SentryIDs)
+	diags.Append(d...)
+	m.LastUpdated = types.StringNull()
+	if !playbook.UpdatedAt.IsZero() {
+		m.LastUpdated = types.StringValue(playbook.UpdatedAt.Format(time.RFC3339))
+	}
+
+	steps := make([]PlaybookStepModel, len(playbook.Steps))
+	for i, step := range playbook.Steps {
+		steps[i] = PlaybookStepModel{
+			ID:               types.StringValue(step.ID),
+			Action:           types.StringValue(step.Action),
+			Parameters:       types.MapNull(types.StringType),
+			RunIf:            types.StringValue(step.RunIf),
+			MinSeverity:      optionalString(step.MinSeverity),
+			RequiresApproval: types.BoolValue(step.RequiresApproval),
+			Timeout:          durationValue(currentTimeouts[step.ID], step.Timeout),
+		}
+		if len(step.Parameters) > 0 {
+			steps[i].Parameters, d = types.MapValueFrom(ctx, types.StringType, step.Parameters)
+			diags.Append(d...)
+		}
+		steps[i].DependsOn, d = optionalStringList(ctx, step.DependsOn)
+		diags.Append(d...)
+		steps[i].Approvers, d = optionalStringList(ctx, step.Approvers)
+		diags.Append(d...)
+	}
+
+	m.Steps, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: PlaybookStepModel{}.AttributeTypes()}, steps)
+	diags.Append(d...)
+
+	return diags
+}
+
+// optionalStringList returns a null list for empty values, which the Sentinel
+// API uses for omitted optional attributes.
+func optionalStringList(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
@@ -0,0 +1,143 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func playbookStep(id string, dependsOn ...string) PlaybookStepModel {
+	step := PlaybookStepModel{
+		ID:        types.StringValue(id),
+		DependsOn: types.ListNull(types.StringType),
+	}
+	if len(dependsOn) > 0 {
+		values := make([]attr.Value, len(dependsOn))
+		for i, dependency := range dependsOn {
+			values[i] = types.StringValue(dependency)
+		}
+		step.DependsOn = types.ListValueMust(types.StringType, values)
+	}
+	return step
+}
+
+func TestValidatePlaybookGraph(t *testing.T) {
+	tests := map[string]struct {
+		steps   []PlaybookStepModel
+		summary string
+		detail  string
+	}{
+		"dag": {
+			steps: []PlaybookStepModel{
+				playbookStep("ticket"),
+				playbookStep("isolate", "ticket"),
+				playbookStep("block", "ticket"),
+				playbookStep("notify", "isolate", "block"),
+			},
+		},
+		"duplicate": {
+			steps:   []PlaybookStepModel{playbookStep("ticket"), playbookStep("ticket")},
+			summary: "Duplicate Playbook Step",
+		},
+		"unknown dependency": {
+			steps:   []PlaybookStepModel{playbookStep("notify", "isolate")},
+			summary: "Unknown Playbook Step",
+		},
+		"self dependency": {
+			steps:   []PlaybookStepModel{playbookStep("notify", "notify")},
+			summary: "Playbook Step Depends On Itself",
+		},
+		"cycle": {
+			steps: []PlaybookStepModel{
+				playbookStep("ticket"),
+				playbookStep("isolate", "ticket", "notify"),
+				playbookStep("block", "isolate"),
+				playbookStep("notify", "block"),
+			},
+			summary: "Playbook Step Cycle",
+			detail:  "isolate -> notify -> block -> isolate",
+		},
+		"unknown": {
+			steps: []PlaybookStepModel{
+				playbookStep("isolate"),
+				{ID: types.StringValue("notify"), DependsOn: types.ListUnknown(types.StringType)},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := validatePlaybookGraph(context.Background(), test.steps)
+
+			if test.summary == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("Expected one error, got %v", diags)
+			}
+			if diags[0].Summary() != test.summary {
+				t.Errorf("Expected %q, got %q", test.summary, diags[0].Summary())
+			}
+			if !strings.Contains(diags[0].Detail(), test.detail) {
+				t.Errorf("Expected detail to contain %q, got %q", test.detail, diags[0].Detail())
+			}
+		})
+	}
+}
+
+func TestResponsePlaybookResourceModelNormalizedTimeouts(t *testing.T) {
+	ctx := context.Background()
+
+	step := func(id, timeout string) PlaybookStepModel {
+		return PlaybookStepModel{
+			ID:               types.StringValue(id),
+			Action:           types.StringValue("notify"),
+			Parameters:       types.MapNull(types.StringType),
+			DependsOn:        types.ListNull(types.StringType),
+			RunIf:            types.StringValue("always"),
+			MinSeverity:      types.StringNull(),
+			RequiresApproval: types.BoolValue(false),
+			Approvers:        types.ListNull(types.StringType),
+			Timeout:          types.StringValue(timeout),
+		}
+	}
+	steps, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: PlaybookStepModel{}.AttributeTypes()}, []PlaybookStepModel{
+		step("page", "90s"),
+		step("isolate", "2h"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	model := ResponsePlaybookResourceModel{Steps: steps}
+
+	// The API spells the timeout of page differently; the timeout of isolate
+	// was changed outside Terraform and a step was added.
+	diags = model.SetAPIResponsePlaybook(ctx, client.ResponsePlaybook{
+		ID: "rp-123", Name: "ransomware", Enabled: true,
+		Steps: []client.PlaybookStep{
+			{ID: "page", Action: "notify", RunIf: "always", Timeout: "1m30s"},
+			{ID: "isolate", Action: "notify", RunIf: "always", Timeout: "1h0m0s"},
+			{ID: "ticket", Action: "notify", RunIf: "always", Timeout: "5m0s"},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var read []PlaybookStepModel
+	diags.Append(model.Steps.ElementsAs(ctx, &read, false)...)
+	var timeouts []string
+	for _, step := range read {
+		timeouts = append(timeouts, step.ID.ValueString()+"="+step.Timeout.ValueString())
+	}
+	if expected := "page=90s isolate=1h0m0s ticket=5m0s"; strings.Join(timeouts, " ") != expected {
+		t.Errorf("Expected timeouts %s, got %s", expected, strings.Join(timeouts, " "))
+	}
+}
@@ -0,0 +1,578 @@
+package resources
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &SentryGroupResource{}
+	_ resource.ResourceWithConfigure        = &SentryGroupResource{}
+	_ resource.ResourceWithImportState      = &SentryGroupResource{}
+	_ resource.ResourceWithConfigValidators = &SentryGroupResource{}
+)
+
+// DefaultMaintenanceWindowTimezone is the time zone of maintenance windows that omit it.
+const DefaultMaintenanceWindowTimezone = "UTC"
+
+// ModelChannels lists the accepted values for defaults.model_channel.
+var ModelChannels = []string{"stable", "beta", "canary"}
+
+// Weekdays lists the accepted values for maintenance_windows.days.
+var Weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// startTimeRegexp matches a time of day in 24-hour HH:MM format.
+var startTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// NewSentryGroupResource is a helper function to simplify the provider implementation.
+func NewSentryGroupResource() resource.Resource {
+	return &SentryGroupResource{}
+}
+
+// SentryGroupResource manages a group of sentries that share default settings.
+type SentryGroupResource struct {
+	providerData *ProviderData
+}
+
+// SentryGroupResourceModel describes the sentry group resource data model.
+type SentryGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Selector    types.Object `tfsdk:"selector"`
+	Defaults    types.Object `tfsdk:"defaults"`
+	MemberIDs   types.List   `tfsdk:"member_ids"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// SentryGroupSelectorModel describes the sentries that are members of a group.
+type SentryGroupSelectorModel struct {
+	SentryIDs types.List   `tfsdk:"sentry_ids"`
+	Sector    types.String `tfsdk:"sector"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+// AttributeTypes returns the attribute types of the selector object.
+func (m SentryGroupSelectorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sentry_ids": types.ListType{ElemType: types.StringType},
+		"sector":     types.StringType,
+		"tags":       types.MapType{ElemType: types.StringType},
+	}
+}
+
+// SentryGroupDefaultsModel describes the settings member sentries inherit.
+type SentryGroupDefaultsModel struct {
+	Alerting           types.Object `tfsdk:"alerting"`
+	MaintenanceWindows types.List   `tfsdk:"maintenance_windows"`
+	ModelChannel       types.String `tfsdk:"model_channel"`
+}
+
+// AttributeTypes returns the attribute types of the defaults object.
+func (m SentryGroupDefaultsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"alerting":            types.ObjectType{AttrTypes: AlertingModel{}.AttributeTypes()},
+		"maintenance_windows": types.ListType{ElemType: types.ObjectType{AttrTypes: MaintenanceWindowModel{}.AttributeTypes()}},
+		"model_channel":       types.StringType,
+	}
+}
+
+// MaintenanceWindowModel describes a recurring maintenance window.
+type MaintenanceWindowModel struct {
+	Days      types.List   `tfsdk:"days"`
+	StartTime types.String `tfsdk:"start_time"`
+	Duration  types.String `tfsdk:"duration"`
+	Timezone  types.String `tfsdk:"timezone"`
+}
+
+// AttributeTypes returns the attribute types of a maintenance window object.
+func (m MaintenanceWindowModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"days":       types.ListType{ElemType: types.StringType},
+		"start_time": types.StringType,
+		"duration":   types.StringType,
+		"timezone":   types.StringType,
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *SentryGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sentry_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *SentryGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a group of sentries, e.g. per region or business unit. Members are listed explicitly or " +
+			"selected by sector and tags, and inherit the group defaults for the settings they do not set themselves.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the sentry group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the sentry group.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the sentry group.",
+				Optional:    true,
+			},
+			"selector": schema.SingleNestedAttribute{
+				Description: "The members of the group: either sentry_ids, or the sentries matching sector and all of tags.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"sentry_ids": schema.ListAttribute{
+						Description: "The IDs of the member sentries.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+						},
+					},
+					"sector": schema.StringAttribute{
+						Description: "Selects the sentries of this sector, including ones created later.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(catalog.Sectors()...),
+						},
+					},
+					"tags": schema.MapAttribute{
+						Description: "Selects the sentries carrying all of these tags, including ones created later.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"defaults": schema.SingleNestedAttribute{
+				Description: "Settings that member sentries inherit unless they set them themselves.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"alerting": schema.SingleNestedAttribute{
+						Description: "Default alerting settings of the members.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"threshold": schema.StringAttribute{
+								Description: "Minimum severity that raises an alert: low, medium, high or critical.",
+								Optional:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(SeverityLevels...),
+								},
+							},
+							"emails": schema.ListAttribute{
+								Description: "Email addresses that receive alerts from the members.",
+								ElementType: types.StringType,
+								Optional:    true,
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+									listvalidator.ValueStringsAre(
+										stringvalidator.RegexMatches(emailRegexp, "must be a valid email address"),
+									),
+								},
+							},
+						},
+					},
+					"maintenance_windows": schema.ListNestedAttribute{
+						Description: "Recurring windows in which the members suppress alerts and may be updated to a new model version.",
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"days": schema.ListAttribute{
+									Description: "The days of the week the window starts on, e.g. saturday.",
+									ElementType: types.StringType,
+									Required:    true,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf(Weekdays...)),
+									},
+								},
+								"start_time": schema.StringAttribute{
+									Description: "The time of day the window starts, in 24-hour HH:MM format.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(startTimeRegexp, "must be a time of day in HH:MM format"),
+									},
+								},
+								"duration": schema.StringAttribute{
+									Description: "How long the window lasts, e.g. 4h.",
+									Required:    true,
+									Validators: []validator.String{
+										durationValidator{min: 15 * time.Minute, max: 24 * time.Hour},
+									},
+								},
+								"timezone": schema.StringAttribute{
+									Description: "The IANA time zone of start_time, e.g. Europe/Berlin. Defaults to UTC.",
+									Optional:    true,
+									Computed:    true,
+									Default:     stringdefault.StaticString(DefaultMaintenanceWindowTimezone),
+									Validators: []validator.String{
+										timezoneValidator{},
+									},
+								},
+							},
+						},
+					},
+					"model_channel": schema.StringAttribute{
+						Description: "The release channel the members receive detection model updates from: stable, beta or canary.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(ModelChannels...),
+						},
+					},
+				},
+			},
+			"member_ids": schema.ListAttribute{
+				Description: "The IDs of the sentries currently in the group, as resolved by the Sentinel API.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update to the sentry group (RFC3339 format).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires either explicit sentry IDs or a sector and tag query.
+func (r *SentryGroupResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("selector").AtName("sentry_ids"),
+			path.MatchRoot("selector").AtName("sector"),
+			path.MatchRoot("selector").AtName("tags"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("selector").AtName("sentry_ids"),
+			path.MatchRoot("selector").AtName("sector"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("selector").AtName("sentry_ids"),
+			path.MatchRoot("selector").AtName("tags"),
+		),
+	}
+}
+
+// Configure adds the provider configuration to the resource.
+func (r *SentryGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// Create creates the sentry group and sets the initial Terraform state.
+func (r *SentryGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SentryGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := plan.APISentryGroup(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating sentry group", map[string]interface{}{
+		"name":     group.Name,
+		"selector": group.Selector,
+	})
+
+	created, err := apiClient.CreateSentryGroup(ctx, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Sentry Group", "Could not create sentry group "+group.Name+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPISentryGroup(ctx, *created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data, including the
+// current members, removing the sentry group from the state when it was
+// deleted outside of Terraform.
+func (r *SentryGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SentryGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading sentry group", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	group, err := apiClient.GetSentryGroup(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Sentry Group", "Could not read sentry group "+state.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.SetAPISentryGroup(ctx, *group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the sentry group and sets the updated Terraform state on success.
+func (r *SentryGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SentryGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := plan.APISentryGroup(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating sentry group", map[string]interface{}{
+		"id":       plan.ID.ValueString(),
+		"name":     group.Name,
+		"selector": group.Selector,
+	})
+
+	updated, err := apiClient.UpdateSentryGroup(ctx, plan.ID.ValueString(), group)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Sentry Group", "Could not update sentry group "+plan.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPISentryGroup(ctx, *updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the sentry group and removes the Terraform state on success.
+// The member sentries are kept.
+func (r *SentryGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SentryGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting sentry group", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	err := apiClient.DeleteSentryGroup(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Sentry Group", "Could not delete sentry group "+state.ID.ValueString()+": "+err.Error())
+	}
+}
+
+// ImportState imports an existing sentry group into Terraform by ID.
+func (r *SentryGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// APISentryGroup converts the model into the sentry group sent to the Sentinel API.
+func (m SentryGroupResourceModel) APISentryGroup(ctx context.Context) (client.SentryGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	group := client.SentryGroup{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+
+	var selector SentryGroupSelectorModel
+	diags.Append(m.Selector.As(ctx, &selector, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return group, diags
+	}
+	group.Selector.Sector = selector.Sector.ValueString()
+	if !selector.SentryIDs.IsNull() {
+		diags.Append(selector.SentryIDs.ElementsAs(ctx, &group.Selector.SentryIDs, false)...)
+	}
+	if !selector.Tags.IsNull() {
+		diags.Append(selector.Tags.ElementsAs(ctx, &group.Selector.Tags, false)...)
+	}
+
+	if m.Defaults.IsNull() {
+		return group, diags
+	}
+
+	var defaults SentryGroupDefaultsModel
+	diags.Append(m.Defaults.As(ctx, &defaults, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return group, diags
+	}
+	group.Defaults.ModelChannel = defaults.ModelChannel.ValueString()
+
+	if !defaults.Alerting.IsNull() {
+		var alerting AlertingModel
+		diags.Append(defaults.Alerting.As(ctx, &alerting, basetypes.ObjectAsOptions{})...)
+		group.Defaults.AlertThreshold = alerting.Threshold.ValueString()
+		if !alerting.Emails.IsNull() {
+			diags.Append(alerting.Emails.ElementsAs(ctx, &group.Defaults.AlertEmails, false)...)
+		}
+	}
+
+	if !defaults.MaintenanceWindows.IsNull() {
+		var windows []MaintenanceWindowModel
+		diags.Append(defaults.MaintenanceWindows.ElementsAs(ctx, &windows, false)...)
+		for _, window := range windows {
+			apiWindow := client.MaintenanceWindow{
+				StartTime: window.StartTime.ValueString(),
+				Duration:  window.Duration.ValueString(),
+				Timezone:  window.Timezone.ValueString(),
+			}
+			diags.Append(window.Days.ElementsAs(ctx, &apiWindow.Days, false)...)
+			group.Defaults.MaintenanceWindows = append(group.Defaults.MaintenanceWindows, apiWindow)
+		}
+	}
+
+	return group, diags
+}
+
+// SetAPISentryGroup populates the model from a sentry group returned by the
+// Sentinel API. The API does not distinguish omitted defaults from empty
+// ones, so defaults and defaults.alerting stay null when they were null
+// before and the API returns no values for them.
+func (m *SentryGroupResourceModel) SetAPISentryGroup(ctx context.Context, group client.SentryGroup) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	priorDefaults, priorAlerting := !m.Defaults.IsNull(), false
+	if priorDefaults && !m.Defaults.IsUnknown() {
+		var prior SentryGroupDefaultsModel
+		diags.Append(m.Defaults.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+		priorAlerting = !prior.Alerting.IsNull()
+	}
+
+	m.ID = types.StringValue(group.ID)
+	m.Name = types.StringValue(group.Name)
+	m.Description = optionalString(group.Description)
+	m.LastUpdated = types.StringNull()
+	if !group.UpdatedAt.IsZero() {
+		m.LastUpdated = types.StringValue(group.UpdatedAt.Format(time.RFC3339))
+	}
+
+	selector := SentryGroupSelectorModel{
+		Sector: optionalString(group.Selector.Sector),
+		Tags:   types.MapNull(types.StringType),
+	}
+	selector.SentryIDs, d = optionalStringList(ctx, group.Selector.SentryIDs)
+	diags.Append(d...)
+	if len(group.Selector.Tags) > 0 {
+		selector.Tags, d = types.MapValueFrom(ctx, types.StringType, group.Selector.Tags)
+		diags.Append(d...)
+	}
+	m.Selector, d = types.ObjectValueFrom(ctx, selector.AttributeTypes(), selector)
+	diags.Append(d...)
+
+	m.MemberIDs, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(group.MemberIDs))
+	diags.Append(d...)
+
+	apiDefaults := group.Defaults
+	hasAlerting := apiDefaults.AlertThreshold != "" || len(apiDefaults.AlertEmails) > 0
+	if !priorDefaults && !hasAlerting && len(apiDefaults.MaintenanceWindows) == 0 && apiDefaults.ModelChannel == "" {
+		m.Defaults = types.ObjectNull(SentryGroupDefaultsModel{}.AttributeTypes())
+		return diags
+	}
+
+	defaults := SentryGroupDefaultsModel{
+		Alerting:           types.ObjectNull(AlertingModel{}.AttributeTypes()),
+		MaintenanceWindows: types.ListNull(types.ObjectType{AttrTypes: MaintenanceWindowModel{}.AttributeTypes()}),
+		ModelChannel:       optionalString(apiDefaults.ModelChannel),
+	}
+
+	if priorAlerting || hasAlerting {
+		alerting := AlertingModel{
+			Threshold: optionalString(apiDefaults.AlertThreshold),
+		}
+		alerting.Emails, d = optionalStringList(ctx, apiDefaults.AlertEmails)
+		diags.Append(d...)
+		defaults.Alerting, d = types.ObjectValueFrom(ctx, alerting.AttributeTypes(), alerting)
+		diags.Append(d...)
+	}
+
+	if len(apiDefaults.MaintenanceWindows) > 0 {
+		windows := make([]MaintenanceWindowModel, len(apiDefaults.MaintenanceWindows))
+		for i, window := range apiDefaults.MaintenanceWindows {
+			windows[i] = MaintenanceWindowModel{
+				StartTime: types.StringValue(window.StartTime),
+				Duration:  types.StringValue(window.Duration),
+				Timezone:  types.StringValue(window.Timezone),
+			}
+			windows[i].Days, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(window.Days))
+			diags.Append(d...)
+		}
+		defaults.MaintenanceWindows, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: MaintenanceWindowModel{}.AttributeTypes()}, windows)
+		diags.Append(d...)
+	}
+
+	m.Defaults, d = types.ObjectValueFrom(ctx, defaults.AttributeTypes(), defaults)
+	diags.Append(d...)
+
+	return diags
+}
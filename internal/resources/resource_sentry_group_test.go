@@ -0,0 +1,121 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSentryGroupResourceModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	window := types.ObjectValueMust(MaintenanceWindowModel{}.AttributeTypes(), map[string]attr.Value{
+		"days":       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("saturday")}),
+		"start_time": types.StringValue("02:00"),
+		"duration":   types.StringValue("4h"),
+		"timezone":   types.StringValue("Europe/Oslo"),
+	})
+	model := SentryGroupResourceModel{
+		Name:        types.StringValue("north-grid"),
+		Description: types.StringNull(),
+		Selector: types.ObjectValueMust(SentryGroupSelectorModel{}.AttributeTypes(), map[string]attr.Value{
+			"sentry_ids": types.ListNull(types.StringType),
+			"sector":     types.StringValue("Energy"),
+			"tags":       types.MapValueMust(types.StringType, map[string]attr.Value{"region": types.StringValue("north")}),
+		}),
+		Defaults: types.ObjectValueMust(SentryGroupDefaultsModel{}.AttributeTypes(), map[string]attr.Value{
+			"alerting": types.ObjectValueMust(AlertingModel{}.AttributeTypes(), map[string]attr.Value{
+				"threshold": types.StringValue("high"),
+				"emails":    types.ListNull(types.StringType),
+			}),
+			"maintenance_windows": types.ListValueMust(window.Type(ctx), []attr.Value{window}),
+			"model_channel":       types.StringNull(),
+		}),
+	}
+
+	group, diags := model.APISentryGroup(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if group.Selector.Sector != "Energy" || group.Selector.Tags["region"] != "north" || group.Selector.SentryIDs != nil {
+		t.Errorf("Unexpected selector: %+v", group.Selector)
+	}
+	if group.Defaults.AlertThreshold != "high" || group.Defaults.ModelChannel != "" {
+		t.Errorf("Unexpected defaults: %+v", group.Defaults)
+	}
+	if len(group.Defaults.MaintenanceWindows) != 1 || group.Defaults.MaintenanceWindows[0].Timezone != "Europe/Oslo" {
+		t.Errorf("Unexpected maintenance windows: %+v", group.Defaults.MaintenanceWindows)
+	}
+
+	// A group read back unchanged must not show drift.
+	group.ID = "sg-123"
+	group.MemberIDs = []string{"ra-1", "ra-2"}
+	group.UpdatedAt = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	read := model
+	diags = read.SetAPISentryGroup(ctx, group)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(read.MemberIDs.Elements()) != 2 {
+		t.Errorf("Expected 2 member IDs, got %s", read.MemberIDs)
+	}
+	for name, pair := range map[string][2]attr.Value{
+		"description": {model.Description, read.Description},
+		"selector":    {model.Selector, read.Selector},
+		"defaults":    {model.Defaults, read.Defaults},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			t.Errorf("Expected %s to be %s, got %s", name, pair[0], pair[1])
+		}
+	}
+
+	// Omitted defaults stay null, and empty ones stay empty.
+	for _, defaults := range []types.Object{
+		types.ObjectNull(SentryGroupDefaultsModel{}.AttributeTypes()),
+		types.ObjectValueMust(SentryGroupDefaultsModel{}.AttributeTypes(), map[string]attr.Value{
+			"alerting":            types.ObjectNull(AlertingModel{}.AttributeTypes()),
+			"maintenance_windows": types.ListNull(window.Type(ctx)),
+			"model_channel":       types.StringNull(),
+		}),
+	} {
+		read := model
+		read.Defaults = defaults
+		diags = read.SetAPISentryGroup(ctx, client.SentryGroup{ID: "sg-123"})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if !read.Defaults.Equal(defaults) {
+			t.Errorf("Expected defaults %s, got %s", defaults, read.Defaults)
+		}
+	}
+}
+
+func TestTimezoneValidator(t *testing.T) {
+	for value, valid := range map[string]bool{
+		"UTC":            true,
+		"Europe/Oslo":    true,
+		"America/Denver": true,
+		"Local":          false,
+		"Mars/Olympus":   false,
+		"+02:00":         false,
+	} {
+		resp := &validator.StringResponse{}
+		timezoneValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("timezone"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("Expected %q valid=%t, got diagnostics %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,510 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &SentryLinkResource{}
+	_ resource.ResourceWithConfigure      = &SentryLinkResource{}
+	_ resource.ResourceWithImportState    = &SentryLinkResource{}
+	_ resource.ResourceWithValidateConfig = &SentryLinkResource{}
+	_ resource.ResourceWithModifyPlan     = &SentryLinkResource{}
+)
+
+// Defaults applied to sentry links when the attributes are omitted.
+const (
+	DefaultSentryLinkSharingLevel  = "amber"
+	DefaultSentryLinkLatencyBudget = "30s"
+)
+
+// SharingLevels lists the accepted values for sharing_level, the Traffic
+// Light Protocol (TLP 2.0) labels from least to most restrictive.
+var SharingLevels = []string{"clear", "green", "amber", "amber+strict", "red"}
+
+// NewSentryLinkResource is a helper function to simplify the provider implementation.
+func NewSentryLinkResource() resource.Resource {
+	return &SentryLinkResource{}
+}
+
+// SentryLinkResource manages a directed intelligence-sharing relationship between two sentries.
+type SentryLinkResource struct {
+	providerData *ProviderData
+}
+
+// SentryLinkResourceModel describes the sentry link resource data model.
+type SentryLinkResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	SourceSentryID types.String `tfsdk:"source_sentry_id"`
+	TargetSentryID types.String `tfsdk:"target_sentry_id"`
+	Categories     types.List   `tfsdk:"categories"`
+	SharingLevel   types.String `tfsdk:"sharing_level"`
+	LatencyBudget  types.String `tfsdk:"latency_budget"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (r *SentryLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sentry_link"
+}
+
+// Schema defines the schema for the resource.
+func (r *SentryLinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a directed intelligence-sharing link: the source sentry shares the events of the given " +
+			"categories with the target sentry, e.g. a sentinel_ra in the Energy sector with a sentinel_lir in the " +
+			"Water sector. Links must not form cycles, and links to sentries of another tenant require a sharing " +
+			"agreement between the tenants.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the sentry link.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_sentry_id": schema.StringAttribute{
+				Description: "The ID of the sentry that shares its events. Changing it replaces the link.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_sentry_id": schema.StringAttribute{
+				Description: "The ID of the sentry that receives the events, optionally qualified with its tenant as " +
+					"<tenant>/<id>. Changing it replaces the link.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"categories": schema.ListAttribute{
+				Description: "The event categories the source shares, e.g. intrusion or lateral_movement.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"sharing_level": schema.StringAttribute{
+				Description: "The Traffic Light Protocol level of the shared events, which limits how the target may " +
+					"pass them on: clear, green, amber, amber+strict or red. Defaults to amber.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(DefaultSentryLinkSharingLevel),
+				Validators: []validator.String{
+					stringvalidator.OneOf(SharingLevels...),
+				},
+			},
+			"latency_budget": schema.StringAttribute{
+				Description: "How long delivering an event to the target may take before it is reported as late, " +
+					"e.g. 5s. Defaults to 30s.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(DefaultSentryLinkLatencyBudget),
+				Validators: []validator.String{
+					durationValidator{min: time.Second, max: time.Hour},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether events are shared over the link. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last update to the sentry link (RFC3339 format).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects links from a sentry to itself.
+func (r *SentryLinkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SentryLinkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SourceSentryID.IsUnknown() || config.TargetSentryID.IsUnknown() {
+		return
+	}
+
+	tenant := r.providerTenant()
+	if qualifySentryID(config.SourceSentryID.ValueString(), tenant) == qualifySentryID(config.TargetSentryID.ValueString(), tenant) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_sentry_id"),
+			"Sentry Link To Itself",
+			fmt.Sprintf("Sentry %q cannot share events with itself.", config.SourceSentryID.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan rejects new links that would close a cycle with the existing
+// links. Links created in the same apply cannot be checked here and are
+// rejected by the Sentinel API instead.
+func (r *SentryLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the link is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SentryLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceSentryID.IsUnknown() || plan.TargetSentryID.IsUnknown() {
+		return
+	}
+
+	// Existing links only need checking when they are replaced.
+	replaced := ""
+	if !req.State.Raw.IsNull() {
+		var state SentryLinkResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.SourceSentryID.Equal(plan.SourceSentryID) && state.TargetSentryID.Equal(plan.TargetSentryID) {
+			return
+		}
+		replaced = state.ID.ValueString()
+	}
+
+	// The provider is not configured yet when its configuration depends on
+	// other resources.
+	if r.providerData == nil || r.providerData.Client == nil {
+		return
+	}
+
+	var links []client.SentryLink
+	for link, err := range r.providerData.Client.ListSentryLinks(ctx) {
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Sentry Link Cycles Not Checked",
+				"Could not list the existing sentry links, so cycles are only detected when the link is created: "+err.Error(),
+			)
+			return
+		}
+		links = append(links, link)
+	}
+
+	if cycle := findSentryLinkCycle(links, replaced, r.providerTenant(), plan.SourceSentryID.ValueString(), plan.TargetSentryID.ValueString()); cycle != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_sentry_id"),
+			"Sentry Link Cycle",
+			"Sentry links must not form cycles, but this link would close the cycle "+strings.Join(cycle, " -> ")+".",
+		)
+	}
+}
+
+// Configure adds the provider configuration to the resource.
+func (r *SentryLinkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.providerData = configureProviderData(req, resp)
+}
+
+// Create creates the sentry link and sets the initial Terraform state.
+func (r *SentryLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SentryLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, diags := plan.APISentryLink(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating sentry link", map[string]interface{}{
+		"source_sentry_id": link.SourceSentryID,
+		"target_sentry_id": link.TargetSentryID,
+		"sharing_level":    link.SharingLevel,
+	})
+
+	created, err := apiClient.CreateSentryLink(ctx, link)
+	if err != nil {
+		resp.Diagnostics.Append(r.linkError("Error Creating Sentry Link", "create", link, err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPISentryLink(ctx, *created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data, removing the
+// sentry link from the state when it was deleted outside of Terraform.
+func (r *SentryLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SentryLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading sentry link", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	link, err := apiClient.GetSentryLink(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Sentry Link", "Could not read sentry link "+state.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.SetAPISentryLink(ctx, *link)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the sentry link and sets the updated Terraform state on success.
+func (r *SentryLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SentryLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, diags := plan.APISentryLink(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating sentry link", map[string]interface{}{
+		"id":            plan.ID.ValueString(),
+		"sharing_level": link.SharingLevel,
+		"enabled":       link.Enabled,
+	})
+
+	updated, err := apiClient.UpdateSentryLink(ctx, plan.ID.ValueString(), link)
+	if err != nil {
+		resp.Diagnostics.Append(r.linkError("Error Updating Sentry Link", "update", link, err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPISentryLink(ctx, *updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the sentry link and removes the Terraform state on success.
+func (r *SentryLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SentryLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting sentry link", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	err := apiClient.DeleteSentryLink(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Sentry Link", "Could not delete sentry link "+state.ID.ValueString()+": "+err.Error())
+	}
+}
+
+// ImportState imports an existing sentry link into Terraform by ID.
+func (r *SentryLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// linkError explains why the Sentinel API rejected a sentry link: because it
+// would close a cycle, or because sharing between the tenants of the two
+// sentries is not allowed.
+func (r *SentryLinkResource) linkError(summary, action string, link client.SentryLink, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	description := fmt.Sprintf("sentry link from %s to %s", link.SourceSentryID, link.TargetSentryID)
+	sourceTenant, targetTenant := r.sentryTenant(link.SourceSentryID), r.sentryTenant(link.TargetSentryID)
+
+	switch {
+	case client.IsConflict(err):
+		diags.AddAttributeError(
+			path.Root("target_sentry_id"),
+			"Sentry Link Cycle",
+			fmt.Sprintf("Could not %s %s because it would close a cycle of sentry links, which the Sentinel API does not allow. "+
+				"Remove one of the links in the cycle first: %s", action, description, err),
+		)
+	case client.IsForbidden(err) && sourceTenant != targetTenant:
+		diags.AddAttributeError(
+			path.Root("target_sentry_id"),
+			"Cross-Tenant Sentry Link Not Allowed",
+			fmt.Sprintf("Could not %s %s: the Sentinel API does not allow sharing events from tenant %q with tenant %q "+
+				"at sharing level %s. Ask the administrators of both tenants for a sharing agreement: %s",
+				action, description, sourceTenant, targetTenant, link.SharingLevel, err),
+		)
+	default:
+		diags.AddError(summary, fmt.Sprintf("Could not %s %s: %s", action, description, err))
+	}
+
+	return diags
+}
+
+// sentryTenant returns the tenant of a sentry: the one it is qualified with,
+// or the provider's tenant.
+func (r *SentryLinkResource) sentryTenant(id string) string {
+	if tenant, _ := splitSentryTenant(id); tenant != "" {
+		return tenant
+	}
+	return r.providerTenant()
+}
+
+// providerTenant returns the tenant the provider is configured for, or "" if
+// it is not configured yet.
+func (r *SentryLinkResource) providerTenant() string {
+	if r.providerData != nil {
+		return r.providerData.Tenant
+	}
+	return ""
+}
+
+// findSentryLinkCycle returns the sentries of the cycle that a new link from
+// source to target would close with the existing links, starting and ending
+// with source, or nil when there is none. The link with ID exclude is
+// ignored, as it is being replaced. Sentry IDs are qualified with tenant
+// before they are compared.
+func findSentryLinkCycle(links []client.SentryLink, exclude, tenant, source, target string) []string {
+	edges := make(map[string][]string)
+	for _, link := range links {
+		if link.ID != exclude {
+			from := qualifySentryID(link.SourceSentryID, tenant)
+			edges[from] = append(edges[from], qualifySentryID(link.TargetSentryID, tenant))
+		}
+	}
+	source, target = qualifySentryID(source, tenant), qualifySentryID(target, tenant)
+
+	// Breadth-first search for a path from target back to source, so that
+	// the shortest cycle is reported.
+	previous := map[string]string{target: ""}
+	queue := []string{target}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node == source {
+			cycle := []string{source}
+			for n := source; n != ""; n = previous[n] {
+				cycle = append(cycle, n)
+			}
+			// The path was collected from source back to target, reverse
+			// everything after the leading source.
+			for i, j := 1, len(cycle)-1; i < j; i, j = i+1, j-1 {
+				cycle[i], cycle[j] = cycle[j], cycle[i]
+			}
+			return cycle
+		}
+		for _, next := range edges[node] {
+			if _, seen := previous[next]; !seen {
+				previous[next] = node
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+// APISentryLink converts the model into the sentry link sent to the Sentinel API.
+func (m SentryLinkResourceModel) APISentryLink(ctx context.Context) (client.SentryLink, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	link := client.SentryLink{
+		SourceSentryID: m.SourceSentryID.ValueString(),
+		TargetSentryID: m.TargetSentryID.ValueString(),
+		SharingLevel:   m.SharingLevel.ValueString(),
+		LatencyBudget:  m.LatencyBudget.ValueString(),
+		Enabled:        m.Enabled.ValueBool(),
+	}
+	diags.Append(m.Categories.ElementsAs(ctx, &link.Categories, false)...)
+
+	return link, diags
+}
+
+// SetAPISentryLink populates the model from a sentry link returned by the Sentinel API.
+func (m *SentryLinkResourceModel) SetAPISentryLink(ctx context.Context, link client.SentryLink) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(link.ID)
+	m.SourceSentryID = types.StringValue(link.SourceSentryID)
+	m.TargetSentryID = types.StringValue(link.TargetSentryID)
+	m.SharingLevel = types.StringValue(link.SharingLevel)
+	m.LatencyBudget = types.StringValue(link.LatencyBudget)
+	m.Enabled = types.BoolValue(link.Enabled)
+	m.LastUpdated = types.StringNull()
+	if !link.UpdatedAt.IsZero() {
+		m.LastUpdated = types.StringValue(link.UpdatedAt.Format(time.RFC3339))
+	}
+
+	categories, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(link.Categories))
+	diags.Append(d...)
+	m.Categories = categories
+
+	return diags
+}
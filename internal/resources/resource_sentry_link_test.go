@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFindSentryLinkCycle(t *testing.T) {
+	links := []client.SentryLink{
+		{ID: "sl-1", SourceSentryID: "ra-grid-1", TargetSentryID: "lir-water-1"},
+		{ID: "sl-2", SourceSentryID: "lir-water-1", TargetSentryID: "sobek-dam-1"},
+		{ID: "sl-3", SourceSentryID: "ra-grid-1", TargetSentryID: "sobek-dam-1"},
+		{ID: "sl-4", SourceSentryID: "snt_01HZX3", TargetSentryID: "grid-co/snt_01J0A7"},
+	}
+
+	tests := map[string]struct {
+		exclude        string
+		tenant         string
+		source, target string
+		expected       []string
+	}{
+		"new branch": {
+			source: "sobek-dam-1", target: "thoth-lab-1",
+		},
+		"reverse link": {
+			source: "lir-water-1", target: "ra-grid-1",
+			expected: []string{"lir-water-1", "ra-grid-1", "lir-water-1"},
+		},
+		"shortest cycle": {
+			source: "sobek-dam-1", target: "ra-grid-1",
+			expected: []string{"sobek-dam-1", "ra-grid-1", "sobek-dam-1"},
+		},
+		"qualified reverse link": {
+			tenant: "grid-co",
+			source: "grid-co/lir-water-1", target: "ra-grid-1",
+			expected: []string{"grid-co/lir-water-1", "grid-co/ra-grid-1", "grid-co/lir-water-1"},
+		},
+		"other tenant": {
+			tenant: "grid-co",
+			source: "water-co/lir-water-1", target: "ra-grid-1",
+		},
+		"server-style reverse link": {
+			tenant: "grid-co",
+			source: "snt_01J0A7", target: "grid-co/snt_01HZX3",
+			expected: []string{"grid-co/snt_01J0A7", "grid-co/snt_01HZX3", "grid-co/snt_01J0A7"},
+		},
+		"server-style other tenant": {
+			tenant: "grid-co",
+			source: "water-co/snt_01J0A7", target: "snt_01HZX3",
+		},
+		"replaced link": {
+			exclude: "sl-1",
+			source:  "lir-water-1", target: "ra-grid-1",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cycle := findSentryLinkCycle(links, test.exclude, test.tenant, test.source, test.target)
+			if !slices.Equal(cycle, test.expected) {
+				t.Errorf("Expected cycle %v, got %v", test.expected, cycle)
+			}
+		})
+	}
+}
+
+func TestSentryLinkError(t *testing.T) {
+	r := &SentryLinkResource{providerData: &ProviderData{Tenant: "grid-co"}}
+
+	for name, test := range map[string]struct {
+		target     string
+		statusCode int
+		summary    string
+	}{
+		"cycle":                     {target: "lir-water-1", statusCode: http.StatusConflict, summary: "Sentry Link Cycle"},
+		"cross-tenant":              {target: "water-co/lir-water-1", statusCode: http.StatusForbidden, summary: "Cross-Tenant Sentry Link Not Allowed"},
+		"same tenant":               {target: "grid-co/lir-water-1", statusCode: http.StatusForbidden, summary: "Error Creating Sentry Link"},
+		"cross-tenant server-style": {target: "water-co/snt_01J0A7", statusCode: http.StatusForbidden, summary: "Cross-Tenant Sentry Link Not Allowed"},
+		"same tenant server-style":  {target: "grid-co/snt_01J0A7", statusCode: http.StatusForbidden, summary: "Error Creating Sentry Link"},
+		"other":                     {target: "lir-water-1", statusCode: http.StatusInternalServerError, summary: "Error Creating Sentry Link"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			link := client.SentryLink{SourceSentryID: "ra-grid-1", TargetSentryID: test.target, SharingLevel: "amber"}
+			diags := r.linkError("Error Creating Sentry Link", "create", link, &client.APIError{StatusCode: test.statusCode})
+
+			if diags.ErrorsCount() != 1 || diags[0].Summary() != test.summary {
+				t.Errorf("Expected %q, got %v", test.summary, diags)
+			}
+		})
+	}
+}
+
+func TestSentryLinkValidateConfigSelfLink(t *testing.T) {
+	ctx := context.Background()
+	r := &SentryLinkResource{providerData: &ProviderData{Tenant: "grid-co"}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for name, test := range map[string]struct {
+		source, target string
+		expectError    bool
+	}{
+		"same id":                   {source: "ra-grid-1", target: "ra-grid-1", expectError: true},
+		"qualified source":          {source: "grid-co/ra-grid-1", target: "ra-grid-1", expectError: true},
+		"qualified target":          {source: "ra-grid-1", target: "grid-co/ra-grid-1", expectError: true},
+		"other tenant":              {source: "water-co/ra-grid-1", target: "ra-grid-1"},
+		"other sentry":              {source: "ra-grid-1", target: "lir-water-1"},
+		"server-style":              {source: "snt_01HZX3", target: "grid-co/snt_01HZX3", expectError: true},
+		"server-style other tenant": {source: "snt_01HZX3", target: "water-co/snt_01HZX3"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for attribute, attributeType := range objectType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+			values["source_sentry_id"] = tftypes.NewValue(tftypes.String, test.source)
+			values["target_sentry_id"] = tftypes.NewValue(tftypes.String, test.target)
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("Expected error %t, got %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
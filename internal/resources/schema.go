@@ -1,14 +1,35 @@
 package resources
 
 import (
+	"regexp"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// configKeyRegexp matches canonically spelled config keys.
+var configKeyRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// emailRegexp is a deliberately loose check that catches obvious typos in alert addresses.
+var emailRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
 // GetCommonSentrySchema returns the common schema attributes for all sentry resources
 func GetCommonSentrySchema(sectorName, description string) schema.Schema {
+	// The catalog entry decides the deletion_protection default and the supported config keys.
+	sentry, _ := catalog.LookupSector(sectorName)
+
 	return schema.Schema{
 		Description: description,
 		Attributes: map[string]schema.Attribute{
@@ -44,10 +65,113 @@ func GetCommonSentrySchema(sectorName, description string) schema.Schema {
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether the sentry is protected from deletion. While true, Terraform refuses to destroy " +
+					"the sentry and the Sentinel API rejects deletes from the console. Defaults to true for sentries " +
+					"of critical sectors (Energy, Nuclear, Dams, Water) and false otherwise.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(sentry.Critical),
+			},
+			"monitoring": schema.SingleNestedAttribute{
+				Description: "Monitoring settings for the sentry.",
+				Optional:    true,
+				Computed:    true,
+				Default:     objectdefault.StaticValue(DefaultMonitoring()),
+				Attributes: map[string]schema.Attribute{
+					"interval_seconds": schema.Int64Attribute{
+						Description: "Interval in seconds between monitoring sweeps. Defaults to 60.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(DefaultMonitoringIntervalSeconds),
+						Validators: []validator.Int64{
+							int64validator.Between(10, 86400),
+						},
+					},
+					"mode": schema.StringAttribute{
+						Description: "Monitoring mode: continuous, scheduled or on_demand. Defaults to continuous.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(DefaultMonitoringMode),
+						Validators: []validator.String{
+							stringvalidator.OneOf(MonitoringModes...),
+						},
+					},
+				},
+			},
+			"alerting": schema.SingleNestedAttribute{
+				Description: "Alerting settings for the sentry.",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"threshold": schema.StringAttribute{
+						Description: "Minimum severity that raises an alert: low, medium, high or critical. " +
+							"When omitted, the threshold of the sentry group the sentry belongs to applies, or else the Sentinel API default.",
+						Optional: true,
+						Computed: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(SeverityLevels...),
+						},
+					},
+					"emails": schema.ListAttribute{
+						Description: "Email addresses that receive alerts from this sentry.",
+						ElementType: schema.StringAttribute{}.GetType(),
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(emailRegexp, "must be a valid email address"),
+							),
+						},
+					},
+				},
+			},
+			"notification_channel_ids": schema.ListAttribute{
+				Description: "The IDs of the sentinel_notification_channel resources alerts from this sentry are delivered to, " +
+					"in addition to alerting.emails.",
+				ElementType: schema.StringAttribute{}.GetType(),
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"threat_level": schema.StringAttribute{
+				Description: "The threat level the sentry operates at: low, medium, high or critical. Defaults to medium.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(DefaultThreatLevel),
+				Validators: []validator.String{
+					stringvalidator.OneOf(SeverityLevels...),
+				},
+			},
 			"config": schema.MapAttribute{
-				Description: "Configuration parameters specific to this sentry.",
+				Description: "Additional configuration parameters specific to this sentry. Keys that have a typed " +
+					"attribute (monitoring_interval, monitoring_mode, alert_threshold, alert_email, threat_level) " +
+					"must be set through that attribute instead. Keys the sentry supports must be spelled canonically and " +
+					"their values are validated; other keys are passed to the Sentinel API with a warning.",
+				ElementType: schema.StringAttribute{}.GetType(),
+				Optional:    true,
+				Validators: []validator.Map{
+					sentryConfigValidator{sentry: sentry},
+				},
+			},
+			"secret_config_wo": schema.MapAttribute{
+				Description: "Secret configuration parameters, such as SIEM tokens or the SMTP password used for alert " +
+					"emails. The values are sent to the Sentinel API but never stored in the plan or state. " +
+					"Requires Terraform 1.11 or later.",
 				ElementType: schema.StringAttribute{}.GetType(),
 				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(configKeyRegexp, "must be lower case with words separated by underscores")),
+					mapvalidator.AlsoRequires(path.MatchRoot("secret_config_wo_version")),
+				},
+			},
+			"secret_config_wo_version": schema.Int64Attribute{
+				Description: "Version of secret_config_wo. Terraform cannot detect changes to write-only values, so " +
+					"change this value to send updated secrets to the Sentinel API.",
+				Optional: true,
 			},
 			"tags": schema.MapAttribute{
 				Description: "A map of tags to assign to the sentry resource.",
@@ -61,3 +185,9 @@ func GetCommonSentrySchema(sectorName, description string) schema.Schema {
 		},
 	}
 }
+
+// sentrySector returns the sector protected by the given sentry type.
+func sentrySector(sentryType string) string {
+	sentry, _ := catalog.Lookup(sentryType)
+	return sentry.Sector
+}
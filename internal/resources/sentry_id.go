@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+)
+
+// SentryID is the parsed form of a sentry ID. IDs of the form
+// <type>-<name>-<created>, where created is a Unix timestamp, may be qualified
+// with the owning tenant as <tenant>/<type>-<name>-<created>. The Sentinel API
+// assigns the IDs of new sentries and does not guarantee this form, so code
+// that handles arbitrary sentry IDs uses splitSentryTenant instead.
+type SentryID struct {
+	Tenant  string
+	Type    string
+	Name    string
+	Created int64
+}
+
+// ParseSentryID parses a sentry ID, optionally qualified with a tenant.
+func ParseSentryID(id string) (SentryID, error) {
+	var result SentryID
+
+	rest := id
+	if tenant, unqualified, ok := strings.Cut(id, "/"); ok {
+		if tenant == "" {
+			return result, fmt.Errorf("sentry ID %q has an empty tenant", id)
+		}
+		result.Tenant = tenant
+		rest = unqualified
+	}
+
+	sentryType, rest, ok := strings.Cut(rest, "-")
+	if !ok {
+		return result, fmt.Errorf("sentry ID %q is not of the form [<tenant>/]<type>-<name>-<created>", id)
+	}
+	if _, ok := catalog.Lookup(sentryType); !ok {
+		return result, fmt.Errorf("sentry ID %q has unknown sentry type %q", id, sentryType)
+	}
+	result.Type = sentryType
+
+	separator := strings.LastIndex(rest, "-")
+	if separator <= 0 {
+		return result, fmt.Errorf("sentry ID %q is not of the form [<tenant>/]<type>-<name>-<created>", id)
+	}
+	result.Name = rest[:separator]
+
+	created, err := strconv.ParseInt(rest[separator+1:], 10, 64)
+	if err != nil {
+		return result, fmt.Errorf("sentry ID %q has an invalid creation timestamp %q", id, rest[separator+1:])
+	}
+	result.Created = created
+
+	return result, nil
+}
+
+// qualifySentryID qualifies id with tenant unless it already names a tenant,
+// so that the IDs of a sentry with and without its tenant compare equal. id is
+// returned unchanged when tenant is empty.
+func qualifySentryID(id, tenant string) string {
+	if idTenant, _ := splitSentryTenant(id); idTenant != "" || tenant == "" {
+		return id
+	}
+	return tenant + "/" + id
+}
+
+// splitSentryTenant splits a sentry ID qualified as <tenant>/<id> into the
+// tenant and the unqualified ID, whatever the form of the unqualified ID. The
+// tenant is empty for unqualified IDs.
+func splitSentryTenant(id string) (tenant, unqualified string) {
+	if tenant, unqualified, ok := strings.Cut(id, "/"); ok {
+		return tenant, unqualified
+	}
+	return "", id
+}
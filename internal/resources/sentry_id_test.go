@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"testing"
+)
+
+func TestParseSentryID(t *testing.T) {
+	testCases := map[string]struct {
+		id       string
+		expected SentryID
+	}{
+		"unqualified": {
+			id:       "apollo-main-hospital-1700000000",
+			expected: SentryID{Type: "apollo", Name: "main-hospital", Created: 1700000000},
+		},
+		"tenant qualified": {
+			id:       "acme/ra-grid-1700000000",
+			expected: SentryID{Tenant: "acme", Type: "ra", Name: "grid", Created: 1700000000},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			parsed, err := ParseSentryID(testCase.id)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if parsed != testCase.expected {
+				t.Errorf("Expected %+v, got %+v", testCase.expected, parsed)
+			}
+		})
+	}
+}
+
+func TestParseSentryIDInvalid(t *testing.T) {
+	for _, id := range []string{
+		"",
+		"apollo",
+		"apollo-1700000000",
+		"zeus-olympus-1700000000",
+		"apollo-hospital-yesterday",
+		"/apollo-hospital-1700000000",
+	} {
+		if _, err := ParseSentryID(id); err == nil {
+			t.Errorf("Expected an error parsing %q", id)
+		}
+	}
+}
+
+func TestSplitSentryTenant(t *testing.T) {
+	testCases := map[string]struct {
+		id                  string
+		tenant, unqualified string
+	}{
+		"unqualified":            {id: "apollo-hospital-1700000000", unqualified: "apollo-hospital-1700000000"},
+		"qualified":              {id: "acme/apollo-hospital-1700000000", tenant: "acme", unqualified: "apollo-hospital-1700000000"},
+		"server assigned":        {id: "snt_01HZX3", unqualified: "snt_01HZX3"},
+		"qualified server style": {id: "acme/snt_01HZX3", tenant: "acme", unqualified: "snt_01HZX3"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tenant, unqualified := splitSentryTenant(testCase.id)
+			if tenant != testCase.tenant || unqualified != testCase.unqualified {
+				t.Errorf("Expected %q and %q, got %q and %q", testCase.tenant, testCase.unqualified, tenant, unqualified)
+			}
+		})
+	}
+}
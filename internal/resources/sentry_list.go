@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SentryListModel describes the list configuration that is common to all sentries.
+type SentryListModel struct {
+	Status     types.String `tfsdk:"status"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+// GetSentryListSchema returns the list schema used by terraform query for the
+// sentries of the given sector.
+func GetSentryListSchema(sectorName string) listschema.Schema {
+	return listschema.Schema{
+		Description: fmt.Sprintf("Lists the existing sentries of the %s sector. All filters are optional and combined.", sectorName),
+		Attributes: map[string]listschema.Attribute{
+			"status": listschema.StringAttribute{
+				Description: "Only list sentries with this status, e.g. active.",
+				Optional:    true,
+			},
+			"enabled": listschema.BoolAttribute{
+				Description: "Only list enabled (true) or disabled (false) sentries.",
+				Optional:    true,
+			},
+			"name_prefix": listschema.StringAttribute{
+				Description: "Only list sentries whose name starts with this prefix.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tags": listschema.MapAttribute{
+				Description: "Only list sentries carrying all of these tag key/value pairs.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// listSentries streams the sentries of the given type that match the list
+// configuration, including their identity and, when requested, their state.
+func listSentries(ctx context.Context, sentryType string, providerData *ProviderData, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	var config SentryListModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	apiClient := providerData.apiClient(&diags)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := client.SentryFilter{
+		Type:       sentryType,
+		Sector:     sentrySector(sentryType),
+		Status:     config.Status.ValueString(),
+		NamePrefix: config.NamePrefix.ValueString(),
+	}
+	if !config.Enabled.IsNull() {
+		enabled := config.Enabled.ValueBool()
+		filter.Enabled = &enabled
+	}
+	if !config.Tags.IsNull() {
+		diags.Append(config.Tags.ElementsAs(ctx, &filter.Tags, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing sentries", map[string]interface{}{
+		"type":        filter.Type,
+		"status":      filter.Status,
+		"name_prefix": filter.NamePrefix,
+		"limit":       req.Limit,
+	})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for sentry, err := range apiClient.ListSentries(ctx, filter) {
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Error Listing Sentries", "Could not list "+sentryType+" sentries: "+err.Error())
+				push(result)
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = sentry.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, newSentryIdentity(providerData, sentryType, sentry.ID))...)
+
+			if req.IncludeResource {
+				var model SentryResourceModel
+				result.Diagnostics.Append(model.SetAPISentry(ctx, sentry)...)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			// Stop once the limit is reached, before the next sentry and
+			// possibly the next page is requested.
+			if !push(result) || (req.Limit > 0 && count >= req.Limit) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListSentriesLimit(t *testing.T) {
+	ctx := context.Background()
+
+	var pageTokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pageToken := r.URL.Query().Get("page_token")
+		pageTokens = append(pageTokens, pageToken)
+
+		page := client.SentryPage{Sentries: []client.Sentry{
+			{ID: "apollo-one-1700000000", Type: "apollo", Name: "one"},
+			{ID: "apollo-two-1700000000", Type: "apollo", Name: "two"},
+		}, NextPageToken: "2"}
+		if pageToken == "2" {
+			page = client.SentryPage{Sentries: []client.Sentry{
+				{ID: "apollo-three-1700000000", Type: "apollo", Name: "three"},
+			}}
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+	providerData := &ProviderData{Client: client.New(server.URL, "test-key", "test")}
+
+	listSchema := GetSentryListSchema("Healthcare")
+	listType := listSchema.Type().TerraformType(ctx).(tftypes.Object)
+	config := make(map[string]tftypes.Value, len(listType.AttributeTypes))
+	for attribute, attributeType := range listType.AttributeTypes {
+		config[attribute] = tftypes.NewValue(attributeType, nil)
+	}
+
+	testCases := map[string]struct {
+		limit              int64
+		expectedNames      []string
+		expectedPageTokens []string
+	}{
+		"within first page": {limit: 1, expectedNames: []string{"one"}, expectedPageTokens: []string{""}},
+		"end of first page": {limit: 2, expectedNames: []string{"one", "two"}, expectedPageTokens: []string{""}},
+		"next page":         {limit: 3, expectedNames: []string{"one", "two", "three"}, expectedPageTokens: []string{"", "2"}},
+		"no limit":          {expectedNames: []string{"one", "two", "three"}, expectedPageTokens: []string{"", "2"}},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pageTokens = nil
+			req := list.ListRequest{
+				Config:                 tfsdk.Config{Schema: listSchema, Raw: tftypes.NewValue(listType, config)},
+				Limit:                  testCase.limit,
+				ResourceSchema:         GetCommonSentrySchema("Healthcare", "test"),
+				ResourceIdentitySchema: GetSentryIdentitySchema(),
+			}
+			stream := &list.ListResultsStream{}
+
+			listSentries(ctx, "apollo", providerData, req, stream)
+
+			var names []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", result.Diagnostics)
+				}
+				names = append(names, result.DisplayName)
+			}
+			if !slices.Equal(names, testCase.expectedNames) {
+				t.Errorf("Expected sentries %v, got %v", testCase.expectedNames, names)
+			}
+			if !slices.Equal(pageTokens, testCase.expectedPageTokens) {
+				t.Errorf("Expected pages %q to be requested, got %q", testCase.expectedPageTokens, pageTokens)
+			}
+		})
+	}
+}
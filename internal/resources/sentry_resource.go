@@ -0,0 +1,199 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cywf/sentinel-provider/internal/catalog"
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sentryDisplayName returns the display name of a sentry type, e.g. Apollo.
+func sentryDisplayName(sentryType string) string {
+	sentry, _ := catalog.Lookup(sentryType)
+	return sentry.Name
+}
+
+// createSentry creates a sentry of the given type through the Sentinel API
+// and sets the initial Terraform state.
+func createSentry(ctx context.Context, sentryType string, providerData *ProviderData, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SentryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sentry, diags := plan.APISentry(ctx, sentryType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sentry.SecretConfig, diags = readSecretConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating "+sentryDisplayName(sentryType)+" sentry", map[string]interface{}{
+		"name":                     sentry.Name,
+		"config":                   sentry.Config,
+		"deletion_protection":      sentry.DeletionProtection,
+		"notification_channel_ids": sentry.NotificationChannelIDs,
+		"secret_config_keys":       secretConfigKeys(sentry.SecretConfig),
+	})
+
+	created, err := apiClient.CreateSentry(ctx, sentry)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Sentry", "Could not create sentinel_"+sentryType+" "+sentry.Name+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPISentry(ctx, *created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setSentryIdentity(ctx, resp.Identity, providerData, sentryType, plan.ID.ValueString())...)
+}
+
+// updateSentry updates a sentry of the given type through the Sentinel API and
+// sets the updated Terraform state on success.
+func updateSentry(ctx context.Context, sentryType string, providerData *ProviderData, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SentryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sentry, diags := plan.APISentry(ctx, sentryType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sentry.SecretConfig, diags = readSecretConfigForUpdate(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating "+sentryDisplayName(sentryType)+" sentry", map[string]interface{}{
+		"id":                       plan.ID.ValueString(),
+		"config":                   sentry.Config,
+		"deletion_protection":      sentry.DeletionProtection,
+		"notification_channel_ids": sentry.NotificationChannelIDs,
+		"secret_config_keys":       secretConfigKeys(sentry.SecretConfig),
+	})
+
+	updated, err := apiClient.UpdateSentry(ctx, plan.ID.ValueString(), sentry)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Sentry", "Could not update sentinel_"+sentryType+" "+plan.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.SetAPISentry(ctx, *updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// deleteSentry deletes a sentry of the given type through the Sentinel API,
+// refusing while its deletion_protection is enabled.
+func deleteSentry(ctx context.Context, sentryType string, providerData *ProviderData, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SentryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDeletionProtection(state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting "+sentryDisplayName(sentryType)+" sentry", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	err := apiClient.DeleteSentry(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Sentry", "Could not delete sentinel_"+sentryType+" "+state.ID.ValueString()+": "+err.Error())
+	}
+}
+
+// readSentry refreshes the Terraform state of a sentry of the given type from
+// the Sentinel API, removing it from the state when it no longer exists.
+func readSentry(ctx context.Context, sentryType string, providerData *ProviderData, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SentryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := providerData.apiClient(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading "+sentryDisplayName(sentryType)+" sentry", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	sentry, err := apiClient.GetSentry(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Sentry no longer exists, removing it from the state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Sentry", "Could not read sentinel_"+sentryType+" "+state.ID.ValueString()+": "+err.Error())
+		return
+	}
+	// Imports can only check the type of IDs of the <type>-<name>-<created> form.
+	if sentry.Type != sentryType {
+		resp.Diagnostics.AddError(
+			"Sentry Type Mismatch",
+			fmt.Sprintf("Sentry %q is a %s sentry and cannot be managed by a sentinel_%s resource.", state.ID.ValueString(), sentry.Type, sentryType),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.SetAPISentry(ctx, *sentry)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setSentryIdentity(ctx, resp.Identity, providerData, sentryType, state.ID.ValueString())...)
+}
@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// sentryValue returns a sentry resource value with the given attributes set
+// and all others null.
+func sentryValue(ctx context.Context, attributes map[string]tftypes.Value) tftypes.Value {
+	objectType := GetCommonSentrySchema("Healthcare", "test").Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// sentryServer serves the sentries API, echoing created and updated sentries
+// and recording every request in requests.
+func sentryServer(t *testing.T, requests *[]client.Sentry, methods *[]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*methods = append(*methods, r.Method+" "+r.URL.Path)
+
+		var sentry client.Sentry
+		if r.Body != nil && r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&sentry); err != nil {
+				t.Errorf("Could not decode request: %v", err)
+			}
+		}
+		*requests = append(*requests, sentry)
+
+		switch r.Method {
+		case http.MethodPost:
+			sentry.ID = "apollo-hospital-1700000000"
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		sentry.Status = "active"
+		_ = json.NewEncoder(w).Encode(sentry)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestCreateSentryDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := GetCommonSentrySchema("Healthcare", "test")
+
+	for name, deletionProtection := range map[string]bool{"enabled": true, "disabled": false} {
+		t.Run(name, func(t *testing.T) {
+			var requests []client.Sentry
+			var methods []string
+			server := sentryServer(t, &requests, &methods)
+			r := &ApolloResource{providerData: &ProviderData{Client: client.New(server.URL, "test-key", "test")}}
+
+			value := sentryValue(ctx, map[string]tftypes.Value{
+				"name":                tftypes.NewValue(tftypes.String, "hospital"),
+				"enabled":             tftypes.NewValue(tftypes.Bool, true),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, deletionProtection),
+			})
+			config := sentryValue(ctx, map[string]tftypes.Value{
+				"name":                tftypes.NewValue(tftypes.String, "hospital"),
+				"enabled":             tftypes.NewValue(tftypes.Bool, true),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, deletionProtection),
+				"secret_config_wo": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"api_token": tftypes.NewValue(tftypes.String, "s3cr3t"),
+				}),
+			})
+			req := resource.CreateRequest{
+				Config: tfsdk.Config{Schema: resourceSchema, Raw: config},
+				Plan:   tfsdk.Plan{Schema: resourceSchema, Raw: value},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)},
+			}
+
+			r.Create(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if len(requests) != 1 || methods[0] != "POST /v1/sentries" {
+				t.Fatalf("Expected a single create request, got %v", methods)
+			}
+			if requests[0].DeletionProtection != deletionProtection {
+				t.Errorf("Expected deletion_protection %t to be sent, got %t", deletionProtection, requests[0].DeletionProtection)
+			}
+			if requests[0].SecretConfig["api_token"] != "s3cr3t" {
+				t.Errorf("Expected secret config to be sent, got %v", requests[0].SecretConfig)
+			}
+
+			var state SentryResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.ID.ValueString() != "apollo-hospital-1700000000" || state.DeletionProtection.ValueBool() != deletionProtection {
+				t.Errorf("Unexpected state %+v", state)
+			}
+		})
+	}
+}
+
+func TestDeleteSentry(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := GetCommonSentrySchema("Healthcare", "test")
+
+	testCases := map[string]struct {
+		deletionProtection bool
+		expectError        bool
+		expectedMethods    int
+	}{
+		"unprotected": {expectedMethods: 1},
+		"protected":   {deletionProtection: true, expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []client.Sentry
+			var methods []string
+			server := sentryServer(t, &requests, &methods)
+			r := &ApolloResource{providerData: &ProviderData{Client: client.New(server.URL, "test-key", "test")}}
+
+			req := resource.DeleteRequest{
+				State: tfsdk.State{Schema: resourceSchema, Raw: sentryValue(ctx, map[string]tftypes.Value{
+					"id":                  tftypes.NewValue(tftypes.String, "apollo-hospital-1700000000"),
+					"name":                tftypes.NewValue(tftypes.String, "hospital"),
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, testCase.deletionProtection),
+				})},
+			}
+			resp := &resource.DeleteResponse{}
+
+			r.Delete(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("Expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+			if len(methods) != testCase.expectedMethods {
+				t.Fatalf("Expected %d requests, got %v", testCase.expectedMethods, methods)
+			}
+			if testCase.expectedMethods > 0 && methods[0] != "DELETE /v1/sentries/apollo-hospital-1700000000" {
+				t.Errorf("Unexpected request %s", methods[0])
+			}
+		})
+	}
+}
+
+func TestReadSentry(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := GetCommonSentrySchema("Healthcare", "test")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/sentries/apollo-hospital-1700000000":
+			_ = json.NewEncoder(w).Encode(client.Sentry{
+				ID: "apollo-hospital-1700000000", Type: "apollo", Name: "hospital", Sector: "Healthcare",
+				Status: "active", Enabled: true, DeletionProtection: true,
+			})
+		case "/v1/sentries/snt_01HZX3":
+			_ = json.NewEncoder(w).Encode(client.Sentry{ID: "snt_01HZX3", Type: "ra", Name: "grid", Sector: "Energy"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	r := &ApolloResource{providerData: &ProviderData{Client: client.New(server.URL, "test-key", "test")}}
+
+	testCases := map[string]struct {
+		id            string
+		expectRemoved bool
+		expectError   bool
+	}{
+		"existing":     {id: "apollo-hospital-1700000000"},
+		"deleted":      {id: "apollo-clinic-1700000000", expectRemoved: true},
+		"another type": {id: "snt_01HZX3", expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{Schema: resourceSchema, Raw: sentryValue(ctx, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, testCase.id),
+				"name": tftypes.NewValue(tftypes.String, "stale"),
+			})}
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("Expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+			if testCase.expectError {
+				return
+			}
+			if resp.State.Raw.IsNull() != testCase.expectRemoved {
+				t.Fatalf("Expected removed %t, got state %v", testCase.expectRemoved, resp.State.Raw)
+			}
+			if testCase.expectRemoved {
+				return
+			}
+
+			var model SentryResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+			if model.Name.ValueString() != "hospital" || !model.DeletionProtection.ValueBool() {
+				t.Errorf("Expected the state to be refreshed, got %+v", model)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/cywf/sentinel-provider/internal/sigma"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = sigmaRuleValidator{}
+
+// sigmaRuleValidator parses a Sigma rule and reports every problem found in
+// its logsource, detection and condition as a separate error.
+type sigmaRuleValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v sigmaRuleValidator) Description(_ context.Context) string {
+	return "must be a valid Sigma rule"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sigmaRuleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v sigmaRuleValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := sigma.Parse(req.ConfigValue.ValueString())
+	if err == nil {
+		return
+	}
+
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Sigma Rule", err.Error())
+	}
+}
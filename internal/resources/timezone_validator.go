@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+	// Embed the time zone database so that validation does not depend on the
+	// zoneinfo files of the machine running Terraform.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = timezoneValidator{}
+
+// timezoneValidator checks that a string is an IANA time zone name, e.g.
+// Europe/Berlin or UTC.
+type timezoneValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v timezoneValidator) Description(_ context.Context) string {
+	return "must be an IANA time zone name such as Europe/Berlin or UTC"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// LoadLocation treats "" and "Local" specially, neither is a zone name.
+	name := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("Value %q %s.", name, v.Description(ctx)),
+		)
+	}
+}
@@ -0,0 +1,396 @@
+// Package sigma parses and validates detection rules written in the Sigma
+// format (https://sigmahq.io), so that invalid rules are rejected at plan time
+// rather than by the Sentinel API.
+package sigma
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Statuses lists the accepted values of the status field.
+var Statuses = []string{"stable", "test", "experimental", "deprecated", "unsupported"}
+
+// Levels lists the accepted values of the level field.
+var Levels = []string{"informational", "low", "medium", "high", "critical"}
+
+// Modifiers lists the accepted value modifiers of detection fields, e.g. the
+// contains in CommandLine|contains.
+var Modifiers = []string{
+	"all", "base64", "base64offset", "cased", "cidr", "contains", "endswith", "exists",
+	"expand", "fieldref", "gt", "gte", "i", "lt", "lte", "m", "re", "s", "startswith",
+	"utf16", "utf16be", "utf16le", "wide", "windash",
+}
+
+// Rule is a parsed Sigma rule.
+type Rule struct {
+	Title       string
+	ID          string
+	Status      string
+	Description string
+	Level       string
+	Tags        []string
+	LogSource   LogSource
+	// Searches holds the names of the search identifiers of the detection,
+	// ordered by name.
+	Searches []string
+	// Conditions holds the condition expressions of the detection. A rule
+	// fires when any of them matches.
+	Conditions []string
+}
+
+// LogSource describes the log data a rule applies to.
+type LogSource struct {
+	Category string
+	Product  string
+	Service  string
+}
+
+// document is the YAML representation of a Sigma rule.
+type document struct {
+	Title       string               `yaml:"title"`
+	ID          string               `yaml:"id"`
+	Status      string               `yaml:"status"`
+	Description string               `yaml:"description"`
+	Level       string               `yaml:"level"`
+	Tags        []string             `yaml:"tags"`
+	LogSource   *logSourceDocument   `yaml:"logsource"`
+	Detection   map[string]yaml.Node `yaml:"detection"`
+}
+
+// logSourceDocument is the YAML representation of the logsource section.
+type logSourceDocument struct {
+	Category string `yaml:"category"`
+	Product  string `yaml:"product"`
+	Service  string `yaml:"service"`
+}
+
+// Parse parses a Sigma rule and validates its logsource, detection and
+// condition. All problems found are returned, joined into one error.
+func Parse(source string) (*Rule, error) {
+	var doc document
+	if err := yaml.Unmarshal([]byte(source), &doc); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	var errs []error
+	rule := &Rule{
+		Title:       doc.Title,
+		ID:          doc.ID,
+		Status:      doc.Status,
+		Description: doc.Description,
+		Level:       doc.Level,
+		Tags:        doc.Tags,
+	}
+
+	if strings.TrimSpace(doc.Title) == "" {
+		errs = append(errs, errors.New("title is required"))
+	}
+	if doc.Status != "" && !slices.Contains(Statuses, doc.Status) {
+		errs = append(errs, fmt.Errorf("status %q must be one of %s", doc.Status, strings.Join(Statuses, ", ")))
+	}
+	if doc.Level != "" && !slices.Contains(Levels, doc.Level) {
+		errs = append(errs, fmt.Errorf("level %q must be one of %s", doc.Level, strings.Join(Levels, ", ")))
+	}
+
+	if doc.LogSource == nil {
+		errs = append(errs, errors.New("logsource is required"))
+	} else {
+		rule.LogSource = LogSource(*doc.LogSource)
+		if rule.LogSource == (LogSource{}) {
+			errs = append(errs, errors.New("logsource must set at least one of category, product and service"))
+		}
+	}
+
+	if doc.Detection == nil {
+		errs = append(errs, errors.New("detection is required"))
+		return rule, errors.Join(errs...)
+	}
+
+	names := make([]string, 0, len(doc.Detection))
+	for name := range doc.Detection {
+		if name != "condition" && name != "timeframe" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if isKeyword(name) {
+			errs = append(errs, fmt.Errorf("detection: search identifier %q is a reserved word", name))
+			continue
+		}
+		node := doc.Detection[name]
+		errs = append(errs, validateSearch(name, &node)...)
+		rule.Searches = append(rule.Searches, name)
+	}
+	if len(rule.Searches) == 0 {
+		errs = append(errs, errors.New("detection must define at least one search identifier"))
+	}
+
+	condition, ok := doc.Detection["condition"]
+	if !ok {
+		errs = append(errs, errors.New("detection: condition is required"))
+		return rule, errors.Join(errs...)
+	}
+	switch condition.Kind {
+	case yaml.ScalarNode:
+		rule.Conditions = []string{condition.Value}
+	case yaml.SequenceNode:
+		for _, item := range condition.Content {
+			if item.Kind != yaml.ScalarNode {
+				errs = append(errs, fmt.Errorf("detection: condition on line %d must be a string", item.Line))
+				continue
+			}
+			rule.Conditions = append(rule.Conditions, item.Value)
+		}
+	default:
+		errs = append(errs, errors.New("detection: condition must be a string or a list of strings"))
+	}
+
+	for _, expression := range rule.Conditions {
+		if err := ValidateCondition(expression, rule.Searches); err != nil {
+			errs = append(errs, fmt.Errorf("detection: condition %q: %w", expression, err))
+		}
+	}
+
+	return rule, errors.Join(errs...)
+}
+
+// validateSearch checks a search identifier: a map of fields to values, a
+// list of such maps, or a list of keywords.
+func validateSearch(name string, node *yaml.Node) []error {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return validateFieldMap(name, node)
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			return []error{fmt.Errorf("detection: %s must not be empty", name)}
+		}
+		var errs []error
+		for _, item := range node.Content {
+			switch item.Kind {
+			case yaml.MappingNode:
+				errs = append(errs, validateFieldMap(name, item)...)
+			case yaml.ScalarNode:
+				// Keyword search.
+			default:
+				errs = append(errs, fmt.Errorf("detection: %s on line %d must list field maps or keywords", name, item.Line))
+			}
+		}
+		return errs
+	default:
+		return []error{fmt.Errorf("detection: %s must be a map of fields or a list", name)}
+	}
+}
+
+// validateFieldMap checks the field names, modifiers and values of a field map.
+func validateFieldMap(name string, node *yaml.Node) []error {
+	if len(node.Content) == 0 {
+		return []error{fmt.Errorf("detection: %s must not be empty", name)}
+	}
+
+	var errs []error
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		parts := strings.Split(key.Value, "|")
+		if parts[0] == "" && len(parts) == 1 {
+			errs = append(errs, fmt.Errorf("detection: %s on line %d has an empty field name", name, key.Line))
+		}
+		for _, modifier := range parts[1:] {
+			if !slices.Contains(Modifiers, modifier) {
+				errs = append(errs, fmt.Errorf("detection: %s field %q on line %d uses unknown modifier %q", name, key.Value, key.Line, modifier))
+			}
+		}
+
+		switch value.Kind {
+		case yaml.ScalarNode:
+		case yaml.SequenceNode:
+			for _, item := range value.Content {
+				if item.Kind != yaml.ScalarNode {
+					errs = append(errs, fmt.Errorf("detection: %s field %q on line %d must list plain values", name, key.Value, item.Line))
+				}
+			}
+		default:
+			errs = append(errs, fmt.Errorf("detection: %s field %q on line %d must be a value or a list of values", name, key.Value, value.Line))
+		}
+	}
+	return errs
+}
+
+// ValidateCondition checks the syntax of a condition expression and that
+// every search identifier it references is defined in searches. Identifier
+// patterns such as selection_* must match at least one search identifier.
+func ValidateCondition(expression string, searches []string) error {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return err
+	}
+	p := &conditionParser{tokens: tokens, searches: searches}
+	if err := p.parseOr(); err != nil {
+		return err
+	}
+	if p.pos < len(p.tokens) {
+		return fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	return nil
+}
+
+// conditionParser is a recursive descent parser for condition expressions:
+//
+//	or      = and { "or" and }
+//	and     = not { "and" not }
+//	not     = "not" not | primary
+//	primary = "(" or ")" | ( "1" | "all" ) "of" ( pattern | "them" ) | identifier
+type conditionParser struct {
+	tokens   []string
+	pos      int
+	searches []string
+}
+
+func (p *conditionParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *conditionParser) next() string {
+	token := p.peek()
+	if token != "" {
+		p.pos++
+	}
+	return token
+}
+
+func (p *conditionParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for strings.EqualFold(p.peek(), "or") {
+		p.next()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *conditionParser) parseAnd() error {
+	if err := p.parseNot(); err != nil {
+		return err
+	}
+	for strings.EqualFold(p.peek(), "and") {
+		p.next()
+		if err := p.parseNot(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *conditionParser) parseNot() error {
+	if strings.EqualFold(p.peek(), "not") {
+		p.next()
+		return p.parseNot()
+	}
+	return p.parsePrimary()
+}
+
+func (p *conditionParser) parsePrimary() error {
+	token := p.next()
+	switch {
+	case token == "":
+		return errors.New("unexpected end of condition")
+	case token == "(":
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		if p.next() != ")" {
+			return errors.New("missing closing parenthesis")
+		}
+		return nil
+	case token == "1" || strings.EqualFold(token, "all"):
+		if !strings.EqualFold(p.next(), "of") {
+			return fmt.Errorf("expected \"of\" after %q", token)
+		}
+		target := p.next()
+		if strings.EqualFold(target, "them") {
+			return nil
+		}
+		if target == "" || isKeyword(target) || target == "(" || target == ")" {
+			return fmt.Errorf("expected a search identifier pattern or \"them\" after \"%s of\"", token)
+		}
+		return p.checkPattern(target)
+	case token == ")" || isKeyword(token):
+		return fmt.Errorf("unexpected %q", token)
+	default:
+		if strings.Contains(token, "*") {
+			return fmt.Errorf("identifier pattern %q must be used with \"1 of\" or \"all of\"", token)
+		}
+		if !slices.Contains(p.searches, token) {
+			return fmt.Errorf("search identifier %q is not defined", token)
+		}
+		return nil
+	}
+}
+
+// checkPattern checks that a search identifier pattern matches at least one search identifier.
+func (p *conditionParser) checkPattern(pattern string) error {
+	for _, search := range p.searches {
+		if matched, _ := path.Match(pattern, search); matched {
+			return nil
+		}
+	}
+	return fmt.Errorf("pattern %q matches no search identifier", pattern)
+}
+
+// tokenize splits a condition expression into parentheses and words.
+func tokenize(expression string) ([]string, error) {
+	var tokens []string
+	var word strings.Builder
+
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+
+	for _, r := range expression {
+		switch {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			flush()
+		case r == '|':
+			return nil, errors.New("aggregations after | are not supported, use a correlation rule instead")
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case r == '_' || r == '*' || r == '-' || r == '.' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+			word.WriteRune(r)
+		default:
+			return nil, fmt.Errorf("unexpected character %q", r)
+		}
+	}
+	flush()
+
+	if len(tokens) == 0 {
+		return nil, errors.New("condition is empty")
+	}
+	return tokens, nil
+}
+
+// isKeyword reports whether word is an operator of the condition syntax.
+func isKeyword(word string) bool {
+	switch strings.ToLower(word) {
+	case "and", "or", "not", "of", "them", "all":
+		return true
+	}
+	return false
+}
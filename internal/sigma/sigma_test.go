@@ -0,0 +1,157 @@
+package sigma
+
+import (
+	"strings"
+	"testing"
+)
+
+const validRule = `
+title: Suspicious PowerShell Download
+id: 3b6ab547-8ec2-4991-b9d2-2b06702a48d7
+status: experimental
+level: high
+tags:
+  - attack.execution
+  - attack.t1059.001
+logsource:
+  category: process_creation
+  product: windows
+detection:
+  selection_img:
+    Image|endswith: '\powershell.exe'
+  selection_cli:
+    CommandLine|contains|all:
+      - 'Net.WebClient'
+      - 'DownloadString'
+  filter_admin:
+    - User: 'SYSTEM'
+    - User: 'svc_backup'
+  condition: all of selection_* and not filter_admin
+`
+
+func TestParse(t *testing.T) {
+	rule, err := Parse(validRule)
+	if err != nil {
+		t.Fatalf("Expected a valid rule, got error: %s", err)
+	}
+
+	if rule.Title != "Suspicious PowerShell Download" || rule.Level != "high" {
+		t.Errorf("Unexpected rule: %+v", rule)
+	}
+	if rule.LogSource != (LogSource{Category: "process_creation", Product: "windows"}) {
+		t.Errorf("Unexpected logsource: %+v", rule.LogSource)
+	}
+	if strings.Join(rule.Searches, ",") != "filter_admin,selection_cli,selection_img" {
+		t.Errorf("Unexpected searches: %v", rule.Searches)
+	}
+	if len(rule.Tags) != 2 || len(rule.Conditions) != 1 {
+		t.Errorf("Unexpected tags %v or conditions %v", rule.Tags, rule.Conditions)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := map[string]struct {
+		source string
+		errors []string
+	}{
+		"yaml": {
+			source: "title: [unterminated",
+			errors: []string{"invalid YAML"},
+		},
+		"missing sections": {
+			source: "title: Empty\n",
+			errors: []string{"logsource is required", "detection is required"},
+		},
+		"empty logsource and bad level": {
+			source: `
+title: Bad
+level: severe
+logsource:
+  definition: anything
+detection:
+  selection:
+    EventID: 4625
+  condition: selection
+`,
+			errors: []string{"level \"severe\"", "logsource must set at least one of"},
+		},
+		"unknown modifier and undefined identifier": {
+			source: `
+title: Bad
+logsource:
+  product: linux
+detection:
+  selection:
+    CommandLine|containz: 'curl'
+  condition: selection or other
+`,
+			errors: []string{"unknown modifier \"containz\"", "search identifier \"other\" is not defined"},
+		},
+		"missing condition": {
+			source: `
+title: Bad
+logsource:
+  product: linux
+detection:
+  selection:
+    CommandLine: 'curl'
+`,
+			errors: []string{"condition is required"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(test.source)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			for _, expected := range test.errors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected error to contain %q, got: %s", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCondition(t *testing.T) {
+	searches := []string{"filter", "selection_a", "selection_b", "keywords"}
+
+	valid := []string{
+		"selection_a",
+		"selection_a and not filter",
+		"(selection_a or selection_b) and not filter",
+		"1 of selection_* and not filter",
+		"all of them",
+		"not 1 of filter*",
+		"keywords AND NOT filter",
+	}
+	for _, condition := range valid {
+		if err := ValidateCondition(condition, searches); err != nil {
+			t.Errorf("Expected %q to be valid, got error: %s", condition, err)
+		}
+	}
+
+	invalid := map[string]string{
+		"":                          "condition is empty",
+		"selection_a and":           "unexpected end of condition",
+		"(selection_a or filter":    "missing closing parenthesis",
+		"selection_a filter":        "unexpected \"filter\"",
+		"selection_*":               "must be used with",
+		"1 of process_*":            "matches no search identifier",
+		"2 of selection_*":          "search identifier \"2\" is not defined",
+		"selection_a | count() > 5": "aggregations after | are not supported",
+		"selection_a && filter":     "unexpected character",
+	}
+	for condition, expected := range invalid {
+		err := ValidateCondition(condition, searches)
+		if err == nil {
+			t.Errorf("Expected %q to be invalid", condition)
+			continue
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error for %q to contain %q, got: %s", condition, expected, err)
+		}
+	}
+}
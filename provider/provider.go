@@ -2,9 +2,17 @@ package provider
 
 import (
 	"context"
+	"os"
 
+	"github.com/cywf/sentinel-provider/internal/client"
+	"github.com/cywf/sentinel-provider/internal/functions"
 	"github.com/cywf/sentinel-provider/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,7 +20,13 @@ import (
 )
 
 // Ensure the implementation satisfies the expected interfaces
-var _ provider.Provider = &SentinelProvider{}
+var (
+	_ provider.Provider                       = &SentinelProvider{}
+	_ provider.ProviderWithFunctions          = &SentinelProvider{}
+	_ provider.ProviderWithEphemeralResources = &SentinelProvider{}
+	_ provider.ProviderWithListResources      = &SentinelProvider{}
+	_ provider.ProviderWithActions            = &SentinelProvider{}
+)
 
 // SentinelProvider defines the provider implementation.
 type SentinelProvider struct {
@@ -24,8 +38,11 @@ type SentinelProvider struct {
 
 // SentinelProviderModel describes the provider data model.
 type SentinelProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	APIKey             types.String `tfsdk:"api_key"`
+	Tenant             types.String `tfsdk:"tenant"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ProtectedTags      types.Map    `tfsdk:"protected_tags"`
 }
 
 // Metadata returns the provider type name.
@@ -50,6 +67,23 @@ func (p *SentinelProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"tenant": schema.StringAttribute{
+				Description: "The tenant the provider operates in. Used as the tenant of sentry resource identities and checked " +
+					"when importing by identity. May also be provided via SENTINEL_TENANT environment variable.",
+				Optional: true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether plans that destroy a protected sentry are rejected. Sentries of critical sectors " +
+					"(Energy, Nuclear, Dams, Water) and sentries matching protected_tags are protected. Defaults to true; " +
+					"set to false to explicitly allow destroying protected sentries.",
+				Optional: true,
+			},
+			"protected_tags": schema.MapAttribute{
+				Description: "Tag key/value pairs that mark a sentry as protected. A sentry carrying any of these tags " +
+					"is treated like a sentry of a critical sector.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -64,16 +98,91 @@ func (p *SentinelProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// Unknown values, e.g. outputs of resources that are not applied yet, cannot
+	// be used to build the client.
+	if config.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown Sentinel API Endpoint",
+			"The provider cannot create the Sentinel API client as the endpoint is unknown until apply. "+
+				"Set the value statically in the configuration, apply its source first, or use the SENTINEL_ENDPOINT environment variable.",
+		)
+	}
+	if config.APIKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unknown Sentinel API Key",
+			"The provider cannot create the Sentinel API client as the API key is unknown until apply. "+
+				"Set the value statically in the configuration, apply its source first, or use the SENTINEL_API_KEY environment variable.",
+		)
+	}
+	if config.Tenant.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tenant"),
+			"Unknown Sentinel Tenant",
+			"The provider cannot determine its tenant as the value is unknown until apply. "+
+				"Set the value statically in the configuration, apply its source first, or use the SENTINEL_TENANT environment variable.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Explicit configuration takes precedence over the environment.
+	endpoint := os.Getenv("SENTINEL_ENDPOINT")
+	if !config.Endpoint.IsNull() {
+		endpoint = config.Endpoint.ValueString()
+	}
+
+	apiKey := os.Getenv("SENTINEL_API_KEY")
+	if !config.APIKey.IsNull() {
+		apiKey = config.APIKey.ValueString()
+	}
+
+	tenant := os.Getenv("SENTINEL_TENANT")
+	if !config.Tenant.IsNull() {
+		tenant = config.Tenant.ValueString()
+	}
+
+	providerData := &resources.ProviderData{
+		Client:             client.New(endpoint, apiKey, p.version),
+		Tenant:             tenant,
+		DeletionProtection: true,
+	}
+
+	if !config.DeletionProtection.IsNull() && !config.DeletionProtection.IsUnknown() {
+		providerData.DeletionProtection = config.DeletionProtection.ValueBool()
+	}
+
+	if !config.ProtectedTags.IsNull() && !config.ProtectedTags.IsUnknown() {
+		resp.Diagnostics.Append(config.ProtectedTags.ElementsAs(ctx, &providerData.ProtectedTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Configuration values are now available for use in resources and data sources
-	// In a production provider, you would create an API client here
-	// For now, we'll pass the configuration to resources
-	resp.DataSourceData = config
-	resp.ResourceData = config
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.ListResourceData = providerData
+	resp.ActionData = providerData
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *SentinelProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		resources.NewSentryDataSource,
+		resources.NewSentriesDataSource,
+		resources.NewSectorsDataSource,
+		resources.NewSentryHealthDataSource,
+		resources.NewCurrentIdentityDataSource,
+		resources.NewThreatLevelDataSource,
+		resources.NewIncidentsDataSource,
+		resources.NewConfigSchemaDataSource,
+		resources.NewModelVersionsDataSource,
+		resources.NewComplianceReportDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -97,6 +206,60 @@ func (p *SentinelProvider) Resources(ctx context.Context) []func() resource.Reso
 		resources.NewSobekResource,
 		resources.NewThothResource,
 		resources.NewTycheResource,
+		resources.NewNotificationChannelResource,
+		resources.NewAlertRuleResource,
+		resources.NewDetectionRuleResource,
+		resources.NewResponsePlaybookResource,
+		resources.NewSentryGroupResource,
+		resources.NewSentryLinkResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *SentinelProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		resources.NewSentryTokenEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider, used by terraform query.
+func (p *SentinelProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.NewApolloListResource,
+		resources.NewAresListResource,
+		resources.NewAthenaListResource,
+		resources.NewDemeterListResource,
+		resources.NewFenrirListResource,
+		resources.NewHermesListResource,
+		resources.NewJupiterListResource,
+		resources.NewLirListResource,
+		resources.NewLughListResource,
+		resources.NewMercuryListResource,
+		resources.NewMorriganListResource,
+		resources.NewOsirisListResource,
+		resources.NewPtahListResource,
+		resources.NewRaListResource,
+		resources.NewShivaListResource,
+		resources.NewSobekListResource,
+		resources.NewThothListResource,
+		resources.NewTycheListResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *SentinelProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		resources.NewSendTestNotificationAction,
+	}
+}
+
+// Functions defines the provider functions implemented in the provider.
+func (p *SentinelProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseSentryIDFunction,
+		functions.NewSectorOfFunction,
+		functions.NewSentryForSectorFunction,
+		functions.NewValidateConfigFunction,
 	}
 }
 
@@ -1,10 +1,15 @@
 package provider
 
 import (
+"context"
 "testing"
 
+"github.com/cywf/sentinel-provider/internal/catalog"
+"github.com/hashicorp/terraform-plugin-framework/provider"
 "github.com/hashicorp/terraform-plugin-framework/providerserver"
+"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 "github.com/hashicorp/terraform-plugin-go/tfprotov6"
+"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -34,3 +39,163 @@ if sentinelProvider.version != "test" {
 t.Errorf("Expected version to be 'test', got '%s'", sentinelProvider.version)
 }
 }
+
+func TestProviderSchemas(t *testing.T) {
+// Schema errors such as defaults that do not match their attribute type are
+// only reported when the provider server builds the schemas.
+server, err := testAccProtoV6ProviderFactories["sentinel"]()
+if err != nil {
+t.Fatalf("Expected provider server, got error: %s", err)
+}
+
+resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+if err != nil {
+t.Fatalf("Expected provider schema, got error: %s", err)
+}
+
+for _, diagnostic := range resp.Diagnostics {
+t.Errorf("Unexpected diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+}
+
+// Every sentry resource can be discovered with terraform query.
+for _, sentry := range catalog.All() {
+name := sentry.ResourceType()
+if _, ok := resp.ResourceSchemas[name]; !ok {
+t.Errorf("Expected a resource schema for %s", name)
+}
+if _, ok := resp.ListResourceSchemas[name]; !ok {
+t.Errorf("Expected a list resource schema for %s", name)
+}
+}
+
+if _, ok := resp.ActionSchemas["sentinel_send_test_notification"]; !ok {
+t.Error("Expected an action schema for sentinel_send_test_notification")
+}
+}
+
+func TestProviderConfigureUnknownValues(t *testing.T) {
+ctx := context.Background()
+p := New("test")()
+
+schemaResp := &provider.SchemaResponse{}
+p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+for _, attribute := range []string{"endpoint", "api_key", "tenant"} {
+t.Run(attribute, func(t *testing.T) {
+values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+for name, attributeType := range objectType.AttributeTypes {
+values[name] = tftypes.NewValue(attributeType, nil)
+}
+values[attribute] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+resp := &provider.ConfigureResponse{}
+p.Configure(ctx, provider.ConfigureRequest{
+Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+}, resp)
+
+if resp.Diagnostics.ErrorsCount() != 1 {
+t.Fatalf("Expected an error for the unknown %s, got %v", attribute, resp.Diagnostics)
+}
+if resp.ResourceData != nil {
+t.Error("Expected the provider to stay unconfigured")
+}
+})
+}
+}
+
+func TestSentryAlertThresholdStateCompatibility(t *testing.T) {
+// Versions before the alerting threshold lost its default stored "medium"
+// in state for sentries that never set it. Such state must still decode and
+// plan without changes, keeping the threshold the API was last sent.
+ctx := context.Background()
+server, err := testAccProtoV6ProviderFactories["sentinel"]()
+if err != nil {
+t.Fatalf("Expected provider server, got error: %s", err)
+}
+
+schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+if err != nil {
+t.Fatalf("Expected provider schema, got error: %s", err)
+}
+resourceSchema := schemaResp.ResourceSchemas["sentinel_apollo"]
+objectType := resourceSchema.ValueType().(tftypes.Object)
+
+upgradeResp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+TypeName: "sentinel_apollo",
+Version:  resourceSchema.Version,
+RawState: &tfprotov6.RawState{JSON: []byte(`{
+"id": "apollo-hospital-1700000000",
+"name": "hospital",
+"description": null,
+"sector": "Healthcare",
+"status": "active",
+"enabled": true,
+"deletion_protection": false,
+"monitoring": {"interval_seconds": 60, "mode": "continuous"},
+"alerting": {"threshold": "medium", "emails": null},
+"notification_channel_ids": null,
+"threat_level": "medium",
+"config": null,
+"secret_config_wo": null,
+"secret_config_wo_version": null,
+"tags": null,
+"last_updated": "2026-01-01T00:00:00Z"
+}`)},
+})
+if err != nil {
+t.Fatalf("Expected upgraded state, got error: %s", err)
+}
+for _, diagnostic := range upgradeResp.Diagnostics {
+t.Fatalf("Unexpected diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+}
+
+priorState, err := upgradeResp.UpgradedState.Unmarshal(objectType)
+if err != nil {
+t.Fatalf("Expected upgraded state to decode, got error: %s", err)
+}
+var prior map[string]tftypes.Value
+if err := priorState.As(&prior); err != nil {
+t.Fatalf("unexpected error: %s", err)
+}
+
+// The configuration omits alerting, as it could with the old default.
+config := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+for _, attribute := range resourceSchema.Block.Attributes {
+config[attribute.Name] = prior[attribute.Name]
+if attribute.Computed && !attribute.Optional {
+config[attribute.Name] = tftypes.NewValue(objectType.AttributeTypes[attribute.Name], nil)
+}
+}
+config["alerting"] = tftypes.NewValue(objectType.AttributeTypes["alerting"], nil)
+configValue, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, config))
+if err != nil {
+t.Fatalf("unexpected error: %s", err)
+}
+
+// Terraform proposes the prior state for optional and computed attributes
+// that are not configured.
+planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+TypeName:         "sentinel_apollo",
+PriorState:       upgradeResp.UpgradedState,
+ProposedNewState: upgradeResp.UpgradedState,
+Config:           &configValue,
+})
+if err != nil {
+t.Fatalf("Expected a plan, got error: %s", err)
+}
+for _, diagnostic := range planResp.Diagnostics {
+t.Fatalf("Unexpected diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+}
+
+plannedState, err := planResp.PlannedState.Unmarshal(objectType)
+if err != nil {
+t.Fatalf("unexpected error: %s", err)
+}
+if !plannedState.Equal(priorState) {
+t.Errorf("Expected no changes, got planned state %s", plannedState)
+}
+if len(planResp.RequiresReplace) > 0 {
+t.Errorf("Expected no replacement, got %v", planResp.RequiresReplace)
+}
+}